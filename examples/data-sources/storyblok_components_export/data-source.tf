@@ -0,0 +1,12 @@
+// Read after the components are applied, so planned components are exported as well
+data "storyblok_components_export" "main" {
+  space_id   = 233252
+  depends_on = [storyblok_component.article, storyblok_component.teaser]
+}
+
+// Write the components in the same place as `storyblok pull-components`, to
+// generate the types with storyblok-generate-ts
+resource "local_file" "components" {
+  filename = "${path.module}/components.233252.json"
+  content  = data.storyblok_components_export.main.json
+}
@@ -0,0 +1,5 @@
+data "storyblok_permissions" "all" {}
+
+output "permissions" {
+  value = { for permission in data.storyblok_permissions.all.permissions : permission.name => permission.description }
+}
@@ -0,0 +1,9 @@
+data "storyblok_sso_collaborators" "main" {
+  space_id = 233252
+  groups   = ["sb-editors", "sb-developers"]
+  strict   = true
+}
+
+output "sso_users" {
+  value = [for user in data.storyblok_sso_collaborators.main.users : user.email]
+}
@@ -0,0 +1,16 @@
+check "webhook_deliveries" {
+  data "storyblok_webhook_logs" "deploy" {
+    space_id   = storyblok_webhook.deploy.space_id
+    webhook_id = storyblok_webhook.deploy.webhook_id
+    limit      = 10
+  }
+
+  assert {
+    condition = alltrue([for delivery in data.storyblok_webhook_logs.deploy.deliveries : delivery.success])
+    error_message = join("\n", [
+      for delivery in data.storyblok_webhook_logs.deploy.deliveries :
+      "${delivery.timestamp} ${delivery.action}: ${delivery.status_code} ${delivery.response_excerpt}"
+      if !delivery.success
+    ])
+  }
+}
@@ -0,0 +1,8 @@
+resource "storyblok_space_role" "editor" {
+  space_id = 233252
+  role     = "Editor"
+
+  field_permissions = [
+    provider::storyblok::field_key("article", "seo_title"),
+  ]
+}
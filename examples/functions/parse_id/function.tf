@@ -0,0 +1,7 @@
+locals {
+  component = provider::storyblok::parse_id(storyblok_component.article.id)
+}
+
+output "component_id" {
+  value = local.component.id
+}
@@ -0,0 +1,4 @@
+// The Management API URL of the space, to configure the provider in another module
+output "storyblok_url" {
+  value = provider::storyblok::region_url(1000123) // https://api-us.storyblok.com
+}
@@ -0,0 +1,14 @@
+resource "storyblok_asset_folder" "brand" {
+  space_id = 233252
+  name     = "brand"
+}
+
+resource "storyblok_asset" "logo" {
+  space_id        = storyblok_asset_folder.brand.space_id
+  source          = "${path.module}/files/logo.png"
+  asset_folder_id = storyblok_asset_folder.brand.asset_folder_id
+  alt             = "ACME logo"
+  title           = "Logo"
+  copyright       = "ACME Inc."
+  focus           = "300x200:301x201"
+}
@@ -3,6 +3,7 @@ resource "storyblok_asset_folder" "parent" {
 }
 
 resource "storyblok_asset_folder" "child" {
-  name      = "child"
-  parent_id = storyblok_asset_folder.parent.asset_folder_id
+  name       = "child"
+  parent_id  = storyblok_asset_folder.parent.asset_folder_id
+  on_destroy = "move_assets_to_parent"
 }
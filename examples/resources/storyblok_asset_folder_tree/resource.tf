@@ -0,0 +1,19 @@
+resource "storyblok_asset_folder_tree" "media" {
+  space_id = 233252
+  paths = [
+    "brand/logos/dark",
+    "brand/logos/light",
+    "campaigns/2026",
+  ]
+
+  # Rename folders in place instead of recreating them
+  moves = {
+    "brand/logo" = "brand/logos"
+  }
+}
+
+resource "storyblok_asset" "logo" {
+  space_id        = 233252
+  source          = "${path.module}/files/logo.png"
+  asset_folder_id = storyblok_asset_folder_tree.media.folder_ids["brand/logos/dark"]
+}
@@ -0,0 +1,16 @@
+resource "storyblok_space_role" "editor" {
+  space_id = "<my-space-id>"
+  role     = "content-editor"
+}
+
+resource "storyblok_collaborator" "jane" {
+  space_id       = "<my-space-id>"
+  email          = "jane@example.com"
+  space_role_ids = [storyblok_space_role.editor.role_id]
+}
+
+resource "storyblok_collaborator" "admin" {
+  space_id = "<my-space-id>"
+  email    = "admin@example.com"
+  role     = "admin"
+}
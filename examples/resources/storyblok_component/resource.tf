@@ -29,6 +29,9 @@ resource "storyblok_component" "advanced_component" {
   is_root     = true
   is_nestable = false
 
+  deletion_protection = true // Refuse to delete the component while stories use it. Default is true.
+  protect_fields      = true // Fail the plan when a field is removed or changes type. Default is to warn.
+
   schema = {
     title = {
       type        = "text"
@@ -41,7 +44,8 @@ resource "storyblok_component" "advanced_component" {
     introduction = {
       type          = "rich_text"
       position      = 2
-      rich_markdown = true // Enable rich markdown view by default
+      renamed_from  = "intro" // Move the content of the old `intro` field in all stories
+      rich_markdown = true    // Enable rich markdown view by default
       description   = "Introduction text with rich text editor"
     }
 
@@ -147,3 +151,92 @@ resource "storyblok_component" "conditional_settings" {
     }
   }
 }
+
+// tabs and sections
+resource "storyblok_component" "tabs" {
+  name     = "article"
+  space_id = "<my-space-id>"
+  is_root  = true
+
+  schema = {
+    title = {
+      type     = "text"
+      position = 1
+    }
+
+    // Fields in tabs and sections don't have a position, it follows from the
+    // order of the tabs and sections
+    seo_title = {
+      type = "text"
+    }
+
+    seo_description = {
+      type = "textarea"
+    }
+
+    og_title = {
+      type = "text"
+    }
+
+    og_image = {
+      type = "asset"
+    }
+  }
+
+  sections = [
+    {
+      key          = "section-social"
+      display_name = "Social media"
+      fields       = ["og_title", "og_image"]
+    }
+  ]
+
+  tabs = [
+    {
+      key          = "tab-seo"
+      display_name = "SEO"
+      fields       = ["seo_title", "seo_description", "section-social"]
+    }
+  ]
+}
+
+// ordered fields, the position of each field follows from the order
+resource "storyblok_component" "ordered_fields" {
+  name        = "quote"
+  space_id    = "<my-space-id>"
+  is_nestable = true
+
+  fields = [
+    {
+      name     = "text"
+      type     = "textarea"
+      required = true
+    },
+    {
+      name = "author"
+      type = "text"
+    },
+  ]
+}
+
+// settings which are not supported by the provider yet can be set with extra_json
+resource "storyblok_component" "extra_json" {
+  name     = "article"
+  space_id = "<my-space-id>"
+  is_root  = true
+
+  extra_json = jsonencode({
+    metadata = { owner = "marketing" }
+  })
+
+  schema = {
+    title = {
+      type     = "text"
+      position = 1
+
+      extra_json = jsonencode({
+        ai_assist = { enabled = true }
+      })
+    }
+  }
+}
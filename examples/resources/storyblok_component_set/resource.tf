@@ -0,0 +1,10 @@
+// Manage the components from the components.json which is written by
+// `storyblok pull-components --space <my-space-id>`, so the same file is used
+// to generate the types of the frontend.
+resource "storyblok_component_set" "frontend" {
+  space_id = "<my-space-id>"
+  json     = file("${path.module}/components.<my-space-id>.json")
+
+  // Refuse to delete components which are used by stories. Default is true.
+  deletion_protection = true
+}
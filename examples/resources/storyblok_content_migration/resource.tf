@@ -0,0 +1,30 @@
+// Migrate the content of the teaser component after changing the `layout`
+// field from an `option` to an `options` field and renaming `headline`.
+resource "storyblok_content_migration" "teaser_layout" {
+  space_id  = "<my-space-id>"
+  name      = "teaser-layout-options"
+  component = "teaser"
+  dry_run   = false // Only list the stories that would change. Default is false.
+
+  transforms = [
+    {
+      rename_field = { from = "headline", to = "title" }
+    },
+    {
+      wrap_value = { field = "layout", type = "list" }
+    },
+    {
+      map_options = {
+        field  = "layout"
+        values = { big = "wide", small = "narrow" }
+      }
+    },
+    {
+      move_to_blok = {
+        fields    = ["button_label", "button_link"]
+        field     = "actions"
+        component = "button"
+      }
+    },
+  ]
+}
@@ -0,0 +1,7 @@
+resource "storyblok_release" "spring_campaign" {
+  space_id           = "<my-space-id>"
+  name               = "Spring campaign"
+  release_at         = "2025-03-01T09:00:00+01:00"
+  timezone           = "Europe/Amsterdam"
+  branches_to_deploy = [1234]
+}
@@ -8,3 +8,28 @@ resource "storyblok_space_role" "my_role" {
   allowed_paths     = [1]
   external_id       = "1234"
 }
+
+// allowed paths as full slug, which are resolved to the story ids when applying
+resource "storyblok_space_role" "blog" {
+  space_id      = "<my-space-id>"
+  role          = "Blog writer"
+  allowed_paths = ["en/blog/", "de/blog/"]
+}
+
+// field permissions as blocks, which are checked against the fields of the
+// component in the space when planning
+resource "storyblok_space_role" "editor" {
+  space_id = "<my-space-id>"
+  role     = "Editor"
+
+  field_permission {
+    component = storyblok_component.article.name
+    field     = "seo_title"
+  }
+
+  field_permission {
+    component = storyblok_component.article.name
+    field     = "slug"
+    readonly  = true
+  }
+}
@@ -0,0 +1,16 @@
+resource "storyblok_webhook" "deploy" {
+  space_id    = "<my-space-id>"
+  name        = "deploy"
+  description = "Rebuild the site when content changes"
+  endpoint    = "https://example.com/deploy"
+  secret      = var.webhook_secret
+  actions = [
+    "story.published",
+    "story.unpublished",
+    "asset.deleted",
+  ]
+
+  // send a signed test delivery and fail when the endpoint doesn't respond
+  // with a 2xx status code
+  verify_on_create = true
+}
@@ -0,0 +1,5 @@
+resource "storyblok_workflow" "editorial" {
+  space_id      = "<my-space-id>"
+  name          = "Editorial"
+  content_types = ["article"]
+}
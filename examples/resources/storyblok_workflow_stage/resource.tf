@@ -0,0 +1,30 @@
+resource "storyblok_space_role" "legal" {
+  space_id = "<my-space-id>"
+  role     = "legal"
+}
+
+resource "storyblok_workflow_stage" "ready" {
+  space_id      = storyblok_workflow.editorial.space_id
+  workflow_id   = storyblok_workflow.editorial.workflow_id
+  name          = "Ready"
+  color         = "#00b3b0"
+  allow_publish = true
+}
+
+resource "storyblok_workflow_stage" "legal_review" {
+  space_id       = storyblok_workflow.editorial.space_id
+  workflow_id    = storyblok_workflow.editorial.workflow_id
+  name           = "Legal review"
+  color          = "#fbce41"
+  space_role_ids = [storyblok_space_role.legal.role_id]
+  next_stage_ids = [storyblok_workflow_stage.ready.workflow_stage_id]
+}
+
+resource "storyblok_workflow_stage" "draft" {
+  space_id        = storyblok_workflow.editorial.space_id
+  workflow_id     = storyblok_workflow.editorial.workflow_id
+  name            = "Draft"
+  is_default      = true
+  allow_all_users = true
+  next_stage_ids  = [storyblok_workflow_stage.legal_review.workflow_stage_id]
+}
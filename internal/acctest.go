@@ -1,12 +1,19 @@
 package internal
 
 import (
+	"crypto/hmac"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+
+	"github.com/labd/terraform-provider-storyblok/internal/webhook"
 )
 
 var (
@@ -47,3 +54,50 @@ func TestAccPreCheck(t *testing.T) func() {
 		}
 	}
 }
+
+// WebhookReceiver is a local webhook endpoint which verifies the signature of
+// the deliveries, like a receiver of Storyblok webhooks would.
+type WebhookReceiver struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	deliveries [][]byte
+}
+
+// NewWebhookReceiver starts a webhook endpoint which responds with 401 when
+// the signature header doesn't match the body and the secret, and with the
+// status code otherwise. The endpoint is closed when the
+// test finishes.
+func NewWebhookReceiver(t *testing.T, secret string, status int) *WebhookReceiver {
+	t.Helper()
+
+	receiver := &WebhookReceiver{}
+	receiver.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		expected := webhook.Sign(secret, body)
+		if !hmac.Equal([]byte(r.Header.Get(webhook.SignatureHeader)), []byte(expected)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte("invalid signature"))
+			return
+		}
+
+		receiver.mu.Lock()
+		receiver.deliveries = append(receiver.deliveries, body)
+		receiver.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(receiver.Close)
+	return receiver
+}
+
+// Deliveries returns the bodies of the deliveries with a valid signature.
+func (r *WebhookReceiver) Deliveries() [][]byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([][]byte(nil), r.deliveries...)
+}
@@ -0,0 +1,117 @@
+package asset
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/gif"  // register decoder for the image size
+	_ "image/jpeg" // register decoder for the image size
+	_ "image/png"  // register decoder for the image size
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// assetResourceModel maps the resource schema data.
+type assetResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	AssetID       types.Int64  `tfsdk:"asset_id"`
+	SpaceID       types.Int64  `tfsdk:"space_id"`
+	Source        types.String `tfsdk:"source"`
+	ContentHash   types.String `tfsdk:"content_hash"`
+	AssetFolderID types.Int64  `tfsdk:"asset_folder_id"`
+	Alt           types.String `tfsdk:"alt"`
+	Title         types.String `tfsdk:"title"`
+	Copyright     types.String `tfsdk:"copyright"`
+	Focus         types.String `tfsdk:"focus"`
+	Filename      types.String `tfsdk:"filename"`
+	ContentType   types.String `tfsdk:"content_type"`
+}
+
+// sourceFile is the local file of an asset.
+type sourceFile struct {
+	Name    string
+	Content []byte
+	Hash    string
+}
+
+// readSource reads the file at path and calculates its content hash.
+func readSource(path string) (*sourceFile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(content)
+	return &sourceFile{
+		Name:    filepath.Base(path),
+		Content: content,
+		Hash:    hex.EncodeToString(sum[:]),
+	}, nil
+}
+
+// size returns the dimensions of image files in the `<width>x<height>`
+// format Storyblok expects, or an empty string for other files.
+func (f *sourceFile) size() string {
+	config, _, err := image.DecodeConfig(bytes.NewReader(f.Content))
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%dx%d", config.Width, config.Height)
+}
+
+func (m *assetResourceModel) toUploadInput(f *sourceFile) mapi.SignedUploadInput {
+	return mapi.SignedUploadInput{
+		Id:             utils.KnownInt64Pointer(m.AssetID),
+		Filename:       f.Name,
+		Size:           f.size(),
+		AssetFolderId:  m.AssetFolderID.ValueInt64Pointer(),
+		ValidateUpload: 1,
+	}
+}
+
+func (m *assetResourceModel) toInput() mapi.AssetInput {
+	return mapi.AssetInput{
+		Asset: mapi.AssetBase{
+			AssetFolderId: m.AssetFolderID.ValueInt64Pointer(),
+			Alt:           m.Alt.ValueStringPointer(),
+			Title:         m.Title.ValueStringPointer(),
+			Copyright:     m.Copyright.ValueStringPointer(),
+			Focus:         m.Focus.ValueStringPointer(),
+		},
+	}
+}
+
+func (m *assetResourceModel) fromRemote(spaceID int64, a *mapi.Asset) error {
+	if a == nil {
+		return fmt.Errorf("asset is nil")
+	}
+	m.ID = types.StringValue(utils.CreateIdentifier(spaceID, a.Id))
+	m.AssetID = types.Int64Value(a.Id)
+	m.SpaceID = types.Int64Value(spaceID)
+	m.AssetFolderID = types.Int64PointerValue(a.AssetFolderId)
+	m.Alt = optionalString(m.Alt, a.Alt)
+	m.Title = optionalString(m.Title, a.Title)
+	m.Copyright = optionalString(m.Copyright, a.Copyright)
+	m.Focus = optionalString(m.Focus, a.Focus)
+	m.Filename = types.StringValue(a.Filename)
+	m.ContentType = types.StringValue(a.ContentType)
+	return nil
+}
+
+// optionalString returns the remote value, but keeps the attribute null when
+// it is not set and Storyblok returns an empty string.
+func optionalString(current types.String, remote *string) types.String {
+	if current.IsNull() && (remote == nil || *remote == "") {
+		return types.StringNull()
+	}
+	if remote == nil {
+		return types.StringValue("")
+	}
+	return types.StringValue(*remote)
+}
@@ -0,0 +1,67 @@
+package asset
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+)
+
+func TestReadSource(t *testing.T) {
+	file, err := readSource("../testdata/logo.png")
+	require.NoError(t, err)
+
+	assert.Equal(t, "logo.png", file.Name)
+	assert.Equal(t, "f2f58ad4f2a37df769296e5b0008aad5f9a21450601611a62b3d18d583fa62e9", file.Hash)
+	assert.Equal(t, "4x2", file.size())
+
+	_, err = readSource("../testdata/missing.png")
+	assert.Error(t, err)
+}
+
+func TestAssetResourceModel_ToUploadInput(t *testing.T) {
+	model := &assetResourceModel{
+		AssetID:       types.Int64Unknown(),
+		AssetFolderID: types.Int64Value(12),
+	}
+
+	input := model.toUploadInput(&sourceFile{Name: "notes.txt", Content: []byte("hello")})
+
+	assert.Nil(t, input.Id, "unknown asset ids should not replace an asset")
+	assert.Equal(t, "notes.txt", input.Filename)
+	assert.Equal(t, "", input.Size)
+	assert.Equal(t, int64(12), *input.AssetFolderId)
+	assert.Equal(t, 1, input.ValidateUpload)
+}
+
+func TestAssetResourceModel_FromRemote(t *testing.T) {
+	model := &assetResourceModel{
+		Alt:       types.StringValue("Company logo"),
+		Title:     types.StringNull(),
+		Copyright: types.StringValue("ACME"),
+		Focus:     types.StringNull(),
+	}
+
+	empty := ""
+	alt := "Company logo"
+	err := model.fromRemote(123, &mapi.Asset{
+		Id:          456,
+		Filename:    "https://a.storyblok.com/f/123/4x2/abc/logo.png",
+		ContentType: "image/png",
+		Alt:         &alt,
+		Title:       &empty,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "123/456", model.ID.ValueString())
+	assert.Equal(t, int64(456), model.AssetID.ValueInt64())
+	assert.True(t, model.AssetFolderID.IsNull())
+	assert.Equal(t, types.StringValue("Company logo"), model.Alt)
+	assert.True(t, model.Title.IsNull(), "unset attributes should stay null")
+	assert.Equal(t, types.StringValue(""), model.Copyright, "removed values should be detected")
+	assert.True(t, model.Focus.IsNull())
+	assert.Equal(t, "https://a.storyblok.com/f/123/4x2/abc/logo.png", model.Filename.ValueString())
+}
@@ -0,0 +1,396 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &assetResource{}
+	_ resource.ResourceWithConfigure   = &assetResource{}
+	_ resource.ResourceWithImportState = &assetResource{}
+	_ resource.ResourceWithModifyPlan  = &assetResource{}
+)
+
+// NewAssetResource is a helper function to simplify the provider implementation.
+func NewAssetResource() resource.Resource {
+	return &assetResource{}
+}
+
+// assetResource is the resource implementation.
+type assetResource struct {
+	client *mapi.Client
+}
+
+// Metadata returns the data source type name.
+func (r *assetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset"
+}
+
+// Schema defines the schema for the data source.
+func (r *assetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Assets are the files in the asset library of a space. The file is uploaded from a local path " +
+			"and uploaded again when its content changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The terraform ID of the asset. This is a composite ID, " +
+					"and should not be used as reference",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"asset_id": schema.Int64Attribute{
+				Description: "The ID of the asset.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": schema.Int64Attribute{
+				Description: "The ID of the space.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "The path of the local file to upload. The name of the file is used as the " +
+					"name of the asset.",
+				Required: true,
+			},
+			"content_hash": schema.StringAttribute{
+				Description: "The SHA256 hash of the content of `source`. The file is uploaded again when " +
+					"the hash changes.",
+				Computed: true,
+			},
+			"asset_folder_id": schema.Int64Attribute{
+				Description: "The ID of the asset folder to store the asset in.",
+				Optional:    true,
+			},
+			"alt": schema.StringAttribute{
+				Description: "The alt text of the asset.",
+				Optional:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the asset.",
+				Optional:    true,
+			},
+			"copyright": schema.StringAttribute{
+				Description: "The copyright of the asset.",
+				Optional:    true,
+			},
+			"focus": schema.StringAttribute{
+				Description: "The focus point of an image, in the format `<left>x<top>:<right>x<bottom>`, " +
+					"for example `300x200:301x201`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^\d+x\d+:\d+x\d+$`),
+						"must be in the format <left>x<top>:<right>x<bottom>",
+					),
+				},
+			},
+			"filename": schema.StringAttribute{
+				Description: "The URL of the uploaded file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Description: "The content type of the uploaded file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *assetResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = utils.GetAPIClient(req.ProviderData)
+}
+
+// ModifyPlan calculates the content hash of the source file, so a changed
+// file results in a new upload.
+func (r *assetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan assetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Source.IsUnknown() {
+		plan.ContentHash = types.StringUnknown()
+	} else {
+		file, err := readSource(plan.Source.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source"),
+				"Unable to read asset source",
+				err.Error(),
+			)
+			return
+		}
+		plan.ContentHash = types.StringValue(file.Hash)
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state assetResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !plan.ContentHash.Equal(state.ContentHash) {
+			plan.Filename = types.StringUnknown()
+			plan.ContentType = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *assetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan assetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := plan.SpaceID.ValueInt64()
+
+	asset, d := r.upload(ctx, spaceID, &plan)
+	if d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	// The metadata can't be passed with the upload
+	if !plan.Alt.IsNull() || !plan.Title.IsNull() || !plan.Copyright.IsNull() || !plan.Focus.IsNull() {
+		asset, d = r.update(ctx, spaceID, asset.Id, &plan)
+		if d != nil {
+			resp.Diagnostics.Append(d)
+			return
+		}
+	}
+	tflog.Debug(ctx, spew.Sdump(asset))
+
+	// Map response body to schema and populate Computed attribute values
+	if err := plan.fromRemote(spaceID, asset); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating asset",
+			"Could not create asset, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *assetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state assetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceId, id := utils.ParseIdentifier(state.ID.ValueString())
+
+	content, err := r.client.GetAsset(ctx, spaceId, id)
+	if err == nil && content.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if d := utils.CheckGetError("asset", id, content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	if err := state.fromRemote(spaceId, content.JSON); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Storyblok Asset",
+			"Could not read Storyblok asset ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *assetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan, state assetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := plan.SpaceID.ValueInt64()
+
+	// Replace the file of the existing asset when the content changed
+	if !plan.ContentHash.Equal(state.ContentHash) {
+		if _, d := r.upload(ctx, spaceID, &plan); d != nil {
+			resp.Diagnostics.Append(d)
+			return
+		}
+	}
+
+	asset, d := r.update(ctx, spaceID, plan.AssetID.ValueInt64(), &plan)
+	if d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+	tflog.Debug(ctx, spew.Sdump(asset))
+
+	// Map response body to schema and populate Computed attribute values
+	if err := plan.fromRemote(spaceID, asset); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating asset",
+			"Could not update asset, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *assetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state assetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceId, assetId := utils.ParseIdentifier(state.ID.ValueString())
+	content, err := r.client.DeleteAsset(ctx, spaceId, assetId)
+	if d := utils.CheckDeleteError("asset", content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+}
+
+func (r *assetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// upload performs the signed upload of the source file. When the model
+// already has an asset id the file of that asset is replaced.
+func (r *assetResource) upload(ctx context.Context, spaceID int64, plan *assetResourceModel) (*mapi.Asset, diag.Diagnostic) {
+	file, err := readSource(plan.Source.ValueString())
+	if err != nil {
+		return nil, diag.NewAttributeErrorDiagnostic(
+			path.Root("source"),
+			"Unable to read asset source",
+			err.Error(),
+		)
+	}
+	plan.ContentHash = types.StringValue(file.Hash)
+
+	signed, err := r.client.CreateSignedUpload(ctx, spaceID, plan.toUploadInput(file))
+	if d := checkUploadError("sign", signed, err); d != nil {
+		return nil, d
+	}
+
+	uploaded, err := r.client.UploadAsset(ctx, signed.JSON, file.Name, file.Content)
+	if d := checkUploadError("upload", uploaded, err); d != nil {
+		return nil, d
+	}
+
+	finished, err := r.client.FinishAssetUpload(ctx, spaceID, signed.JSON.Id)
+	if d := checkUploadError("finish", finished, err); d != nil {
+		return nil, d
+	}
+	return finished.JSON, nil
+}
+
+// update sets the metadata of the asset and returns the updated asset.
+func (r *assetResource) update(ctx context.Context, spaceID int64, id int64, plan *assetResourceModel) (*mapi.Asset, diag.Diagnostic) {
+	updated, err := r.client.UpdateAsset(ctx, spaceID, id, plan.toInput())
+	if err != nil || updated.StatusCode() != http.StatusNoContent {
+		if d := utils.CheckUpdateError("asset", updated, err); d != nil {
+			return nil, d
+		}
+	}
+
+	content, err := r.client.GetAsset(ctx, spaceID, id)
+	if d := utils.CheckGetError("asset", id, content, err); d != nil {
+		return nil, d
+	}
+	return content.JSON, nil
+}
+
+func checkUploadError(step string, response utils.ApiResponse, err error) diag.Diagnostic {
+	if err != nil {
+		return diag.NewErrorDiagnostic(
+			"Error uploading asset",
+			fmt.Sprintf("Could not %s the asset upload, unexpected error: %s", step, err.Error()))
+	}
+
+	if response.StatusCode() < 200 || response.StatusCode() >= 300 {
+		return diag.NewErrorDiagnostic(
+			"Error uploading asset",
+			fmt.Sprintf("Could not %s the asset upload, status code: %d", step, response.StatusCode()))
+	}
+
+	return nil
+}
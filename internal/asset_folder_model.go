@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/labd/storyblok-go-sdk/sbmgmt"
 
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
 	"github.com/labd/terraform-provider-storyblok/internal/utils"
 )
 
@@ -16,8 +17,15 @@ type assetFolderResourceModel struct {
 	SpaceID       types.Int64  `tfsdk:"space_id"`
 	Name          types.String `tfsdk:"name"`
 	ParentID      types.Int64  `tfsdk:"parent_id"`
+	OnDestroy     types.String `tfsdk:"on_destroy"`
 }
 
+const (
+	assetFolderFailIfNotEmpty     = "fail_if_not_empty"
+	assetFolderMoveAssetsToParent = "move_assets_to_parent"
+	assetFolderDeleteAssets       = "delete_assets"
+)
+
 func (m *assetFolderResourceModel) toCreateInput() sbmgmt.AssetFolderCreateInput {
 	return sbmgmt.AssetFolderCreateInput{
 		AssetFolder: sbmgmt.AssetFolderBase{
@@ -26,9 +34,12 @@ func (m *assetFolderResourceModel) toCreateInput() sbmgmt.AssetFolderCreateInput
 		},
 	}
 }
-func (m *assetFolderResourceModel) toUpdateInput() sbmgmt.AssetFolderUpdateInput {
-	return sbmgmt.UpdateAssetFolderJSONRequestBody{
-		AssetFolder: sbmgmt.AssetFolderBase{
+
+// toUpdateInput uses the mapi input instead of sbmgmt.AssetFolderUpdateInput,
+// which omits an empty parent_id and thereby can't move a folder to the root.
+func (m *assetFolderResourceModel) toUpdateInput() mapi.AssetFolderInput {
+	return mapi.AssetFolderInput{
+		AssetFolder: mapi.AssetFolderBase{
 			Name:     m.Name.ValueString(),
 			ParentId: m.ParentID.ValueInt64Pointer(),
 		},
@@ -43,8 +54,13 @@ func (m *assetFolderResourceModel) fromRemote(spaceID int64, f *sbmgmt.AssetFold
 	m.AssetFolderID = types.Int64Value(f.Id)
 	m.SpaceID = types.Int64Value(spaceID)
 	m.Name = types.StringValue(f.Name)
-	if f.ParentId != nil {
+	if f.ParentId != nil && *f.ParentId != 0 {
 		m.ParentID = types.Int64Value(*f.ParentId)
+	} else {
+		m.ParentID = types.Int64Null()
+	}
+	if m.OnDestroy.IsNull() || m.OnDestroy.IsUnknown() {
+		m.OnDestroy = types.StringValue(assetFolderFailIfNotEmpty)
 	}
 	return nil
 }
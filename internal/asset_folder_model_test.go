@@ -0,0 +1,40 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/storyblok-go-sdk/sbmgmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetFolderResourceModel_ToUpdateInput(t *testing.T) {
+	model := &assetFolderResourceModel{
+		Name:     types.StringValue("logos"),
+		ParentID: types.Int64Null(),
+	}
+
+	body, err := json.Marshal(model.toUpdateInput())
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"asset_folder":{"name":"logos","parent_id":null}}`, string(body),
+		"moving a folder to the root requires an explicit null parent_id")
+}
+
+func TestAssetFolderResourceModel_FromRemote(t *testing.T) {
+	model := &assetFolderResourceModel{
+		ParentID: types.Int64Value(12),
+	}
+
+	err := model.fromRemote(233252, &sbmgmt.AssetFolder{
+		Id:   34,
+		Name: "logos",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "233252/34", model.ID.ValueString())
+	assert.Equal(t, "logos", model.Name.ValueString())
+	assert.True(t, model.ParentID.IsNull(), "folders moved to the root should clear the parent")
+}
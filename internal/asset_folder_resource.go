@@ -4,17 +4,23 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/labd/storyblok-go-sdk/sbmgmt"
 
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
 	"github.com/labd/terraform-provider-storyblok/internal/utils"
 )
 
@@ -33,6 +39,7 @@ func NewAssetFolderResource() resource.Resource {
 // assetFolderResource is the resource implementation.
 type assetFolderResource struct {
 	client sbmgmt.ClientWithResponsesInterface
+	api    *mapi.Client
 }
 
 // Metadata returns the data source type name.
@@ -73,6 +80,23 @@ func (r *assetFolderResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "The ID of the parent asset folder.",
 				Optional:    true,
 			},
+			"on_destroy": schema.StringAttribute{
+				Description: "What to do with the contents of the folder when it is destroyed. With " +
+					"`fail_if_not_empty` the folder is only deleted when it contains no assets or folders, " +
+					"`move_assets_to_parent` moves the assets and folders to the parent folder and " +
+					"`delete_assets` deletes the assets, but fails when the folder contains folders. " +
+					"Changes to this attribute must be applied before they take effect on destroy.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(assetFolderFailIfNotEmpty),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						assetFolderFailIfNotEmpty,
+						assetFolderMoveAssetsToParent,
+						assetFolderDeleteAssets,
+					),
+				},
+			},
 		},
 	}
 }
@@ -84,6 +108,7 @@ func (r *assetFolderResource) Configure(_ context.Context, req resource.Configur
 	}
 
 	r.client = utils.GetClient(req.ProviderData)
+	r.api = utils.GetAPIClient(req.ProviderData)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -185,11 +210,8 @@ func (r *assetFolderResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	// Generate API request body from plan
-	input := plan.toUpdateInput()
 	spaceID := plan.SpaceID.ValueInt64()
-
-	content, err := r.client.UpdateAssetFolderWithResponse(ctx, spaceID, plan.AssetFolderID.ValueInt64(), input)
+	content, err := r.api.UpdateAssetFolder(ctx, spaceID, plan.AssetFolderID.ValueInt64(), plan.toUpdateInput())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating assetFolder",
@@ -242,6 +264,13 @@ func (r *assetFolderResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	spaceId, assetFolderId := utils.ParseIdentifier(state.ID.ValueString())
+
+	// Handle the contents of the folder before deleting it
+	resp.Diagnostics.Append(r.emptyFolder(ctx, spaceId, assetFolderId, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	content, err := r.client.DeleteAssetFolderWithResponse(ctx, spaceId, assetFolderId)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -265,3 +294,125 @@ func (r *assetFolderResource) ImportState(ctx context.Context, req resource.Impo
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// emptyFolder handles the assets and subfolders of the folder according to the
+// on_destroy policy.
+func (r *assetFolderResource) emptyFolder(ctx context.Context, spaceID int64, id int64, state *assetFolderResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	assets, d := r.listAssets(ctx, spaceID, id)
+	if d != nil {
+		diags.Append(d)
+		return diags
+	}
+
+	folders, err := r.client.ListAssetFoldersWithResponse(ctx, spaceID)
+	if d := utils.CheckGetError("asset folders of space", spaceID, folders, err); d != nil {
+		diags.Append(d)
+		return diags
+	}
+	children := []sbmgmt.AssetFolder{}
+	if folders.JSON200.AssetFolders != nil {
+		for _, f := range *folders.JSON200.AssetFolders {
+			if f.ParentId != nil && *f.ParentId == id {
+				children = append(children, f)
+			}
+		}
+	}
+
+	if len(assets) == 0 && len(children) == 0 {
+		return diags
+	}
+
+	policy := state.OnDestroy.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("asset folder %d contains %d assets and %d folders, on_destroy is %s",
+		id, len(assets), len(children), policy))
+
+	switch policy {
+	case assetFolderMoveAssetsToParent:
+		parentID := state.ParentID.ValueInt64Pointer()
+		for _, asset := range assets {
+			content, err := r.api.MoveAsset(ctx, spaceID, asset.Id, parentID)
+			if err != nil || content.StatusCode() != http.StatusNoContent {
+				if d := utils.CheckUpdateError("asset "+asset.Filename, content, err); d != nil {
+					diags.Append(d)
+					return diags
+				}
+			}
+		}
+		for _, child := range children {
+			input := mapi.AssetFolderInput{
+				AssetFolder: mapi.AssetFolderBase{Name: child.Name, ParentId: parentID},
+			}
+			content, err := r.api.UpdateAssetFolder(ctx, spaceID, child.Id, input)
+			if err != nil || content.StatusCode() != http.StatusNoContent {
+				if d := utils.CheckUpdateError("assetFolder "+child.Name, content, err); d != nil {
+					diags.Append(d)
+					return diags
+				}
+			}
+		}
+
+	case assetFolderDeleteAssets:
+		if len(children) > 0 {
+			diags.AddError(
+				"Error deleting assetFolder",
+				fmt.Sprintf("Could not delete assetFolder %s, it contains %d folders: %s. "+
+					"Delete the folders first, or set on_destroy to move_assets_to_parent.",
+					state.Name.ValueString(), len(children), contentNames(nil, children)),
+			)
+			return diags
+		}
+		for _, asset := range assets {
+			content, err := r.api.DeleteAsset(ctx, spaceID, asset.Id)
+			if d := utils.CheckDeleteError("asset "+asset.Filename, content, err); d != nil {
+				diags.Append(d)
+				return diags
+			}
+		}
+
+	default:
+		diags.AddError(
+			"Error deleting assetFolder",
+			fmt.Sprintf("Could not delete assetFolder %s, it contains %d assets and %d folders (%s). "+
+				"Empty the folder first, or set on_destroy to move_assets_to_parent or delete_assets.",
+				state.Name.ValueString(), len(assets), len(children), contentNames(assets, children)),
+		)
+	}
+
+	return diags
+}
+
+// listAssets returns all assets directly in the folder.
+func (r *assetFolderResource) listAssets(ctx context.Context, spaceID int64, id int64) ([]mapi.Asset, diag.Diagnostic) {
+	const perPage = 100
+
+	result := []mapi.Asset{}
+	for page := 1; ; page++ {
+		content, err := r.api.ListAssets(ctx, spaceID, mapi.ListAssetsParams{FolderId: id, Page: page, PerPage: perPage})
+		if d := utils.CheckGetError("assets of assetFolder", id, content, err); d != nil {
+			return nil, d
+		}
+		result = append(result, content.JSON.Assets...)
+		if len(content.JSON.Assets) < perPage {
+			return result, nil
+		}
+	}
+}
+
+// contentNames lists the first items of the folder for error messages.
+func contentNames(assets []mapi.Asset, children []sbmgmt.AssetFolder) string {
+	const limit = 5
+
+	names := []string{}
+	for _, f := range children {
+		names = append(names, f.Name+"/")
+	}
+	for _, a := range assets {
+		names = append(names, a.ShortFilename)
+	}
+	if len(names) > limit {
+		return strings.Join(names[:limit], ", ") + fmt.Sprintf(" and %d more", len(names)-limit)
+	}
+	return strings.Join(names, ", ")
+}
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -38,6 +39,54 @@ func TestAssetFolderResourceBasic(t *testing.T) {
 	})
 }
 
+func TestAssetFolderResourceOnDestroy(t *testing.T) {
+	f, stop := ProviderFactories("./assets/asset_folder_on_destroy")
+	defer func() {
+		_ = stop()
+	}()
+
+	rn := "storyblok_asset_folder.archive"
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config: testAssetFolderOnDestroyConfig(spaceId, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "on_destroy", "fail_if_not_empty"),
+				),
+			},
+			{
+				// The folder contains an asset uploaded outside of terraform
+				Config:      testAssetFolderOnDestroyConfig(spaceId, ""),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`it contains 1 assets and 0 folders\s+\(archived\.png\)`),
+			},
+			{
+				Config: testAssetFolderOnDestroyConfig(spaceId, "move_assets_to_parent"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "on_destroy", "move_assets_to_parent"),
+				),
+			},
+		},
+	})
+}
+
+func testAssetFolderOnDestroyConfig(spaceId int, onDestroy string) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_asset_folder" "archive" {
+		  space_id   = {{ .spaceId }}
+		  name       = "archive"
+		  {{ if .onDestroy }}on_destroy = "{{ .onDestroy }}"{{ end }}
+		}
+	`, map[string]any{
+		"spaceId":   spaceId,
+		"onDestroy": onDestroy,
+	})
+}
+
 func testAssetFolderConfig(identifier string, spaceId int) string {
 	return utils.HCLTemplate(`
 		resource "storyblok_asset_folder" "{{ .identifier }}" {
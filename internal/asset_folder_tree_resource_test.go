@@ -0,0 +1,70 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+func TestAssetFolderTreeResourceBasic(t *testing.T) {
+	f, stop := ProviderFactories("./assets/asset_folder_tree")
+	defer func() {
+		_ = stop()
+	}()
+
+	spaceId := 233252
+	rn := "storyblok_asset_folder_tree.media"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config: testAssetFolderTreeConfig(spaceId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "folder_ids.%", "4"),
+					resource.TestCheckResourceAttr(rn, "folder_ids.brand", "1001"),
+					resource.TestCheckResourceAttr(rn, "folder_ids.campaigns", "1002"),
+					resource.TestCheckResourceAttr(rn, "folder_ids.brand/logos", "1003"),
+					resource.TestCheckResourceAttr(rn, "folder_ids.brand/logos/dark", "1004"),
+				),
+			},
+			{
+				Config: testAssetFolderTreeConfigUpdate(spaceId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "folder_ids.%", "4"),
+					resource.TestCheckResourceAttr(rn, "folder_ids.brand/logo", "1003"),
+					resource.TestCheckResourceAttr(rn, "folder_ids.brand/logo/dark", "1004"),
+					resource.TestCheckResourceAttr(rn, "folder_ids.brand/logo/light", "1005"),
+					resource.TestCheckNoResourceAttr(rn, "folder_ids.campaigns"),
+				),
+			},
+		},
+	})
+}
+
+func testAssetFolderTreeConfig(spaceId int) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_asset_folder_tree" "media" {
+		  space_id = {{ .spaceId }}
+		  paths    = ["brand/logos/dark", "campaigns"]
+		}
+	`, map[string]any{
+		"spaceId": spaceId,
+	})
+}
+
+func testAssetFolderTreeConfigUpdate(spaceId int) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_asset_folder_tree" "media" {
+		  space_id = {{ .spaceId }}
+		  paths    = ["brand/logo/dark", "brand/logo/light"]
+		  moves = {
+		    "brand/logos" = "brand/logo"
+		  }
+		}
+	`, map[string]any{
+		"spaceId": spaceId,
+	})
+}
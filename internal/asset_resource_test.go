@@ -0,0 +1,77 @@
+package internal
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+func TestAssetResourceBasic(t *testing.T) {
+	f, stop := ProviderFactories("./assets/asset")
+	defer func() {
+		_ = stop()
+	}()
+
+	spaceId := 233252
+	rn := "storyblok_asset.logo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAssetConfig(spaceId, "./testdata/missing.png", "Logo"),
+				ExpectError: regexp.MustCompile("Unable to read asset source"),
+			},
+			{
+				Config: testAssetConfig(spaceId, "./testdata/logo.png", "Logo"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "alt", "Company logo"),
+					resource.TestCheckResourceAttr(rn, "title", "Logo"),
+					resource.TestCheckResourceAttr(rn, "focus", "1x0:2x1"),
+					resource.TestCheckNoResourceAttr(rn, "copyright"),
+					resource.TestCheckResourceAttr(rn, "content_type", "image/png"),
+					resource.TestCheckResourceAttr(rn, "content_hash",
+						"f2f58ad4f2a37df769296e5b0008aad5f9a21450601611a62b3d18d583fa62e9"),
+					resource.TestMatchResourceAttr(rn, "filename",
+						regexp.MustCompile(`^https://a\.storyblok\.com/f/233252/4x2/.+/logo\.png$`)),
+					resource.TestCheckResourceAttrPair(
+						rn, "asset_folder_id",
+						"storyblok_asset_folder.brand", "asset_folder_id"),
+				),
+			},
+			{
+				Config: testAssetConfig(spaceId, "./testdata/logo-dark.png", "Dark logo"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "title", "Dark logo"),
+					resource.TestMatchResourceAttr(rn, "filename",
+						regexp.MustCompile(`/logo-dark\.png$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAssetConfig(spaceId int, source string, title string) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_asset_folder" "brand" {
+		  space_id = {{ .spaceId }}
+		  name     = "brand"
+		}
+
+		resource "storyblok_asset" "logo" {
+		  space_id        = {{ .spaceId }}
+		  source          = "{{ .source }}"
+		  asset_folder_id = storyblok_asset_folder.brand.asset_folder_id
+		  alt             = "Company logo"
+		  title           = "{{ .title }}"
+		  focus           = "1x0:2x1"
+		}
+	`, map[string]any{
+		"spaceId": spaceId,
+		"source":  source,
+		"title":   title,
+	})
+}
@@ -0,0 +1,262 @@
+package assetfolder
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+)
+
+// treeResourceModel maps the resource schema data.
+type treeResourceModel struct {
+	ID        types.String            `tfsdk:"id"`
+	SpaceID   types.Int64             `tfsdk:"space_id"`
+	Paths     []types.String          `tfsdk:"paths"`
+	Moves     map[string]types.String `tfsdk:"moves"`
+	FolderIDs types.Map               `tfsdk:"folder_ids"`
+}
+
+func (m *treeResourceModel) paths() []string {
+	result := make([]string, 0, len(m.Paths))
+	for _, p := range m.Paths {
+		result = append(result, p.ValueString())
+	}
+	return result
+}
+
+func (m *treeResourceModel) moves() map[string]string {
+	result := make(map[string]string, len(m.Moves))
+	for oldPath, newPath := range m.Moves {
+		result[oldPath] = newPath.ValueString()
+	}
+	return result
+}
+
+func (m *treeResourceModel) folderIDs(ctx context.Context) (map[string]int64, diag.Diagnostics) {
+	result := map[string]int64{}
+	if m.FolderIDs.IsNull() || m.FolderIDs.IsUnknown() {
+		return result, nil
+	}
+	diags := m.FolderIDs.ElementsAs(ctx, &result, false)
+	return result, diags
+}
+
+// setFolderIDs stores the folder ids and removes the paths of folders that
+// don't exist, so they are planned to be created again.
+func (m *treeResourceModel) setFolderIDs(ctx context.Context, ids map[string]int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.FolderIDs, diags = types.MapValueFrom(ctx, types.Int64Type, ids)
+
+	paths := []types.String{}
+	for _, p := range m.Paths {
+		if _, ok := ids[p.ValueString()]; ok {
+			paths = append(paths, p)
+		}
+	}
+	if m.Paths != nil {
+		m.Paths = paths
+	}
+	return diags
+}
+
+type treeOperationKind int
+
+const (
+	createFolder treeOperationKind = iota
+	moveFolder
+	deleteFolder
+)
+
+// treeOperation is a single API call needed to reconcile the folder tree.
+type treeOperation struct {
+	Kind treeOperationKind
+	Path string
+	// From is the current path of a folder that is moved or renamed to Path.
+	From string
+}
+
+// expandPaths returns the given paths together with all their parent paths,
+// ordered so parents come before their children.
+func expandPaths(paths []string) []string {
+	seen := map[string]bool{}
+	result := []string{}
+	for _, p := range paths {
+		segments := strings.Split(p, "/")
+		for i := range segments {
+			current := strings.Join(segments[:i+1], "/")
+			if !seen[current] {
+				seen[current] = true
+				result = append(result, current)
+			}
+		}
+	}
+	sortByDepth(result)
+	return result
+}
+
+func sortByDepth(paths []string) {
+	sort.SliceStable(paths, func(i, j int) bool {
+		di, dj := strings.Count(paths[i], "/"), strings.Count(paths[j], "/")
+		if di != dj {
+			return di < dj
+		}
+		return paths[i] < paths[j]
+	})
+}
+
+// planTree returns the operations to turn the current folders into the desired
+// paths. Folders are moved instead of recreated when listed in moves, which
+// maps the old path to the new path. Descendants of a moved folder move with
+// it and need no calls of their own.
+func planTree(current map[string]int64, desired []string, moves map[string]string) []treeOperation {
+	existing := map[string]bool{}
+	for p := range current {
+		existing[p] = true
+	}
+
+	from := map[string]string{}
+	for oldPath, newPath := range moves {
+		from[newPath] = oldPath
+	}
+
+	expanded := expandPaths(desired)
+	wanted := map[string]bool{}
+	for _, p := range expanded {
+		wanted[p] = true
+	}
+
+	ops := []treeOperation{}
+	for _, p := range expanded {
+		if existing[p] {
+			continue
+		}
+		if oldPath, ok := from[p]; ok && existing[oldPath] && !wanted[oldPath] {
+			ops = append(ops, treeOperation{Kind: moveFolder, Path: p, From: oldPath})
+			rebase(existing, oldPath, p)
+			continue
+		}
+		ops = append(ops, treeOperation{Kind: createFolder, Path: p})
+		existing[p] = true
+	}
+
+	remove := []string{}
+	for p := range existing {
+		if !wanted[p] {
+			remove = append(remove, p)
+		}
+	}
+	sortByDepth(remove)
+	for i := len(remove) - 1; i >= 0; i-- {
+		ops = append(ops, treeOperation{Kind: deleteFolder, Path: remove[i]})
+	}
+	return ops
+}
+
+// rebase replaces oldPath with newPath in the keys of oldPath and its
+// descendants.
+func rebase[T any](paths map[string]T, oldPath string, newPath string) {
+	moved := map[string]T{}
+	for p, v := range paths {
+		if p == oldPath || strings.HasPrefix(p, oldPath+"/") {
+			moved[newPath+strings.TrimPrefix(p, oldPath)] = v
+			delete(paths, p)
+		}
+	}
+	for p, v := range moved {
+		paths[p] = v
+	}
+}
+
+// folderName returns the name of the folder at the given path.
+func folderName(p string) string {
+	return path.Base(p)
+}
+
+// parentPath returns the path of the parent folder, or an empty string for
+// folders in the root.
+func parentPath(p string) string {
+	parent := path.Dir(p)
+	if parent == "." {
+		return ""
+	}
+	return parent
+}
+
+// renamedFolders returns the folders which are renamed or moved outside of
+// Terraform, mapping the path in the state to the remote path. Folders which
+// only moved along with a renamed parent are left out.
+func renamedFolders(current map[string]int64, remote map[string]int64) map[string]string {
+	byID := map[int64]string{}
+	for p, id := range remote {
+		byID[id] = p
+	}
+
+	renamed := map[string]string{}
+	for oldPath, id := range current {
+		if newPath, ok := byID[id]; ok && newPath != oldPath {
+			renamed[oldPath] = newPath
+		}
+	}
+
+	result := map[string]string{}
+	for oldPath, newPath := range renamed {
+		parent, ok := renamed[parentPath(oldPath)]
+		if ok && parent == parentPath(newPath) && folderName(oldPath) == folderName(newPath) {
+			continue
+		}
+		result[oldPath] = newPath
+	}
+	return result
+}
+
+// renamePaths replaces the paths of renamed folders, including the paths of
+// their descendants, with the remote paths.
+func (m *treeResourceModel) renamePaths(renamed map[string]string) {
+	oldPaths := make([]string, 0, len(renamed))
+	for oldPath := range renamed {
+		oldPaths = append(oldPaths, oldPath)
+	}
+	sortByDepth(oldPaths)
+
+	for i, p := range m.Paths {
+		value := p.ValueString()
+		for j := len(oldPaths) - 1; j >= 0; j-- {
+			oldPath := oldPaths[j]
+			if value == oldPath || strings.HasPrefix(value, oldPath+"/") {
+				value = renamed[oldPath] + strings.TrimPrefix(value, oldPath)
+			}
+		}
+		m.Paths[i] = types.StringValue(value)
+	}
+}
+
+// remotePaths resolves the current path of the given folder ids. Folders that
+// no longer exist are left out.
+func remotePaths(folders []mapi.AssetFolder, ids map[string]int64) map[string]int64 {
+	byID := map[int64]mapi.AssetFolder{}
+	for _, f := range folders {
+		byID[f.Id] = f
+	}
+
+	result := map[string]int64{}
+	for _, id := range ids {
+		segments := []string{}
+		current, ok := byID[id]
+		for ok {
+			segments = append([]string{current.Name}, segments...)
+			if current.ParentId == nil || *current.ParentId == 0 {
+				break
+			}
+			current, ok = byID[*current.ParentId]
+		}
+		if ok {
+			result[strings.Join(segments, "/")] = id
+		}
+	}
+	return result
+}
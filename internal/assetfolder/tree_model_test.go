@@ -0,0 +1,131 @@
+package assetfolder
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+)
+
+func TestExpandPaths(t *testing.T) {
+	paths := expandPaths([]string{"brand/logos/dark", "campaigns", "brand/icons"})
+
+	assert.Equal(t, []string{
+		"brand",
+		"campaigns",
+		"brand/icons",
+		"brand/logos",
+		"brand/logos/dark",
+	}, paths)
+}
+
+func TestPlanTree_Create(t *testing.T) {
+	ops := planTree(map[string]int64{"brand": 1}, []string{"brand/logos/dark"}, nil)
+
+	assert.Equal(t, []treeOperation{
+		{Kind: createFolder, Path: "brand/logos"},
+		{Kind: createFolder, Path: "brand/logos/dark"},
+	}, ops)
+}
+
+func TestPlanTree_MoveAndDelete(t *testing.T) {
+	current := map[string]int64{
+		"brand":            1,
+		"brand/logos":      2,
+		"brand/logos/dark": 3,
+		"campaigns":        4,
+		"campaigns/2025":   5,
+	}
+
+	ops := planTree(current,
+		[]string{"brand/logo/dark", "brand/logo/light"},
+		map[string]string{"brand/logos": "brand/logo"},
+	)
+
+	assert.Equal(t, []treeOperation{
+		{Kind: moveFolder, Path: "brand/logo", From: "brand/logos"},
+		{Kind: createFolder, Path: "brand/logo/light"},
+		{Kind: deleteFolder, Path: "campaigns/2025"},
+		{Kind: deleteFolder, Path: "campaigns"},
+	}, ops)
+	assert.Len(t, current, 5, "the current folders should not be modified")
+}
+
+func TestPlanTree_MoveToNewParent(t *testing.T) {
+	current := map[string]int64{
+		"logos":      1,
+		"logos/dark": 2,
+	}
+
+	ops := planTree(current, []string{"brand/logos/dark"}, map[string]string{"logos": "brand/logos"})
+
+	assert.Equal(t, []treeOperation{
+		{Kind: createFolder, Path: "brand"},
+		{Kind: moveFolder, Path: "brand/logos", From: "logos"},
+	}, ops)
+}
+
+func TestPlanTree_IgnoresStaleMoves(t *testing.T) {
+	current := map[string]int64{
+		"brand":      1,
+		"brand/logo": 2,
+	}
+
+	ops := planTree(current, []string{"brand/logo"}, map[string]string{"brand/logos": "brand/logo"})
+
+	assert.Empty(t, ops)
+}
+
+func TestRemotePaths(t *testing.T) {
+	parent := int64(1)
+	root := int64(0)
+	folders := []mapi.AssetFolder{
+		{Id: 1, Name: "brand"},
+		{Id: 2, Name: "logo", ParentId: &parent},
+		{Id: 3, Name: "campaigns", ParentId: &root},
+	}
+
+	paths := remotePaths(folders, map[string]int64{
+		"brand":       1,
+		"brand/logos": 2,
+		"campaigns":   3,
+		"removed":     4,
+	})
+
+	assert.Equal(t, map[string]int64{
+		"brand":      1,
+		"brand/logo": 2,
+		"campaigns":  3,
+	}, paths)
+}
+
+func TestRenamedFolders(t *testing.T) {
+	current := map[string]int64{
+		"brand":            1,
+		"brand/logos":      2,
+		"brand/logos/dark": 3,
+		"campaigns":        4,
+		"removed":          5,
+	}
+	remote := map[string]int64{
+		"identity":            1,
+		"identity/logos":      2,
+		"identity/logos/dark": 3,
+		"campaigns/2025":      4,
+	}
+
+	assert.Equal(t, map[string]string{
+		"brand":     "identity",
+		"campaigns": "campaigns/2025",
+	}, renamedFolders(current, remote))
+
+	model := treeResourceModel{Paths: []types.String{
+		types.StringValue("brand/logos/dark"),
+		types.StringValue("campaigns"),
+		types.StringValue("other"),
+	}}
+	model.renamePaths(renamedFolders(current, remote))
+	assert.Equal(t, []string{"identity/logos/dark", "campaigns/2025", "other"}, model.paths())
+}
@@ -0,0 +1,397 @@
+package assetfolder
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &treeResource{}
+	_ resource.ResourceWithConfigure  = &treeResource{}
+	_ resource.ResourceWithModifyPlan = &treeResource{}
+)
+
+var folderPathRegex = regexp.MustCompile(`^[^/]+(/[^/]+)*$`)
+
+// NewTreeResource is a helper function to simplify the provider implementation.
+func NewTreeResource() resource.Resource {
+	return &treeResource{}
+}
+
+// treeResource is the resource implementation.
+type treeResource struct {
+	client *mapi.Client
+}
+
+// Metadata returns the data source type name.
+func (r *treeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_folder_tree"
+}
+
+// Schema defines the schema for the data source.
+func (r *treeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	pathValidator := stringvalidator.RegexMatches(folderPathRegex,
+		"must be a folder path like brand/logos/dark, without leading or trailing slashes")
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a tree of nested asset folders from a list of paths. Parent folders are created " +
+			"automatically, so `brand/logos/dark` results in the folders `brand`, `brand/logos` and " +
+			"`brand/logos/dark`. Folders are only deleted when they contain no assets and no folders outside " +
+			"of the tree. Folders renamed outside of Terraform are reported as changed paths.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The terraform ID of the folder tree, which is the ID of the space.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": schema.Int64Attribute{
+				Description: "The ID of the space.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"paths": schema.SetAttribute{
+				Description: "The paths of the folders, with the folder names separated by a `/`.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(pathValidator),
+				},
+			},
+			"moves": schema.MapAttribute{
+				Description: "Folders to move or rename, mapping the old path to the new path. The folder " +
+					"(including its subfolders and assets) is updated in place instead of being deleted and " +
+					"created again.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(pathValidator),
+					mapvalidator.ValueStringsAre(pathValidator),
+				},
+			},
+			"folder_ids": schema.MapAttribute{
+				Description: "The IDs of all folders in the tree by path, to be used as `asset_folder_id`.",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *treeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = utils.GetAPIClient(req.ProviderData)
+}
+
+// ModifyPlan keeps the folder ids when the tree doesn't change.
+func (r *treeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state treeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, p := range plan.Paths {
+		if p.IsUnknown() {
+			return
+		}
+	}
+
+	current, d := state.folderIDs(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired := expandPaths(plan.paths())
+	if len(desired) != len(current) {
+		return
+	}
+	for _, p := range desired {
+		if _, ok := current[p]; !ok {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("folder_ids"), state.FolderIDs)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *treeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan treeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.FormatInt(plan.SpaceID.ValueInt64(), 10))
+	resp.Diagnostics.Append(r.apply(ctx, &plan, map[string]int64{})...)
+
+	// Set state to the folders that were created, also when the apply failed
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *treeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state treeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := state.SpaceID.ValueInt64()
+
+	content, err := r.client.ListAssetFolders(ctx, spaceID)
+	if d := utils.CheckGetError("asset folders of space", spaceID, content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	current, d := state.folderIDs(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Report folders which are renamed outside of Terraform as changed paths,
+	// instead of planning to create them again
+	remote := remotePaths(content.JSON.AssetFolders, current)
+	renamed := renamedFolders(current, remote)
+	for _, oldPath := range utils.SortedKeys(renamed) {
+		newPath := renamed[oldPath]
+		resp.Diagnostics.AddWarning("Asset folder renamed",
+			fmt.Sprintf("Asset folder %s is renamed to %s outside of Terraform. Update the paths in the "+
+				"configuration, or add \"%s\" = \"%s\" to moves to rename the folder back.",
+				oldPath, newPath, newPath, oldPath))
+	}
+	state.renamePaths(renamed)
+
+	// Overwrite items with refreshed state
+	resp.Diagnostics.Append(state.setFolderIDs(ctx, remote)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *treeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan, state treeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, d := state.folderIDs(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, current)...)
+
+	// Set state to the folders that were changed, also when the apply failed
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *treeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state treeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, d := state.folderIDs(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Paths = nil
+	resp.Diagnostics.Append(r.apply(ctx, &state, current)...)
+}
+
+// apply executes the operations to turn the current folders into the folders
+// of the plan and stores the resulting folder ids in the plan.
+func (r *treeResource) apply(ctx context.Context, plan *treeResourceModel, current map[string]int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	spaceID := plan.SpaceID.ValueInt64()
+
+	ids := map[string]int64{}
+	managed := map[int64]bool{}
+	for p, id := range current {
+		ids[p] = id
+		managed[id] = true
+	}
+	var folders []mapi.AssetFolder
+
+	for _, op := range planTree(current, plan.paths(), plan.moves()) {
+		tflog.Debug(ctx, fmt.Sprintf("asset folder tree: %+v", op))
+
+		switch op.Kind {
+		case createFolder:
+			content, err := r.client.CreateAssetFolder(ctx, spaceID, folderInput(ids, op.Path))
+			if d := utils.CheckCreateError("asset folder "+op.Path, content, err); d != nil {
+				diags.Append(d)
+				break
+			}
+			ids[op.Path] = content.JSON.AssetFolder.Id
+
+		case moveFolder:
+			content, err := r.client.UpdateAssetFolder(ctx, spaceID, ids[op.From], folderInput(ids, op.Path))
+			if d := checkNoContent("Error updating asset folder", "Could not move asset folder "+op.From+" to "+op.Path, content, err); d != nil {
+				diags.Append(d)
+				break
+			}
+			rebase(ids, op.From, op.Path)
+
+		case deleteFolder:
+			if folders == nil {
+				content, err := r.client.ListAssetFolders(ctx, spaceID)
+				if d := utils.CheckGetError("asset folders of space", spaceID, content, err); d != nil {
+					diags.Append(d)
+					break
+				}
+				folders = content.JSON.AssetFolders
+			}
+			if d := r.checkEmpty(ctx, spaceID, op.Path, ids[op.Path], folders, managed); d != nil {
+				diags.Append(d)
+				break
+			}
+
+			content, err := r.client.DeleteAssetFolder(ctx, spaceID, ids[op.Path])
+			if err == nil && content.StatusCode() == http.StatusNotFound {
+				delete(ids, op.Path)
+				break
+			}
+			if d := checkNoContent("Error deleting asset folder", "Could not delete asset folder "+op.Path, content, err); d != nil {
+				diags.Append(d)
+				break
+			}
+			delete(ids, op.Path)
+		}
+
+		if diags.HasError() {
+			break
+		}
+	}
+
+	diags.Append(plan.setFolderIDs(ctx, ids)...)
+	return diags
+}
+
+// checkEmpty returns an error when the folder contains assets or folders which
+// are not part of the tree, so their content isn't deleted with the folder.
+func (r *treeResource) checkEmpty(
+	ctx context.Context, spaceID int64, p string, id int64, folders []mapi.AssetFolder, managed map[int64]bool,
+) diag.Diagnostic {
+	children := []string{}
+	for _, f := range folders {
+		if f.ParentId != nil && *f.ParentId == id && !managed[f.Id] {
+			children = append(children, f.Name)
+		}
+	}
+
+	content, err := r.client.ListAssets(ctx, spaceID, mapi.ListAssetsParams{FolderId: id, Page: 1, PerPage: 1})
+	if d := utils.CheckGetError("assets of asset folder", id, content, err); d != nil {
+		return d
+	}
+	if len(content.JSON.Assets) == 0 && len(children) == 0 {
+		return nil
+	}
+
+	return diag.NewErrorDiagnostic("Error deleting asset folder",
+		fmt.Sprintf("Could not delete asset folder %s, it contains assets or folders which are not part of "+
+			"the tree (%s). Move or delete the contents first, or manage the folder with "+
+			"storyblok_asset_folder and on_destroy.", p, contentSummary(len(content.JSON.Assets) > 0, children)))
+}
+
+// contentSummary describes the contents of a folder which is not empty.
+func contentSummary(hasAssets bool, folders []string) string {
+	parts := []string{}
+	if hasAssets {
+		parts = append(parts, "assets")
+	}
+	if len(folders) > 0 {
+		parts = append(parts, "folders "+strings.Join(folders, ", "))
+	}
+	return strings.Join(parts, " and ")
+}
+
+// folderInput returns the input to create or move a folder to the given path.
+// The parent folder must already exist.
+func folderInput(ids map[string]int64, p string) mapi.AssetFolderInput {
+	input := mapi.AssetFolderInput{
+		AssetFolder: mapi.AssetFolderBase{
+			Name: folderName(p),
+		},
+	}
+	if parent := parentPath(p); parent != "" {
+		id := ids[parent]
+		input.AssetFolder.ParentId = &id
+	}
+	return input
+}
+
+// checkNoContent checks the response of asset folder updates and deletes,
+// which respond with 204 instead of 200.
+func checkNoContent(summary string, detail string, response utils.ApiResponse, err error) diag.Diagnostic {
+	if err != nil {
+		return diag.NewErrorDiagnostic(summary, fmt.Sprintf("%s, unexpected error: %s", detail, err.Error()))
+	}
+
+	if response.StatusCode() != http.StatusNoContent {
+		return diag.NewErrorDiagnostic(summary, fmt.Sprintf("%s, status code: %d", detail, response.StatusCode()))
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package collaborator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// collaboratorResourceModel maps the resource schema data.
+type collaboratorResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	CollaboratorID     types.Int64    `tfsdk:"collaborator_id"`
+	SpaceID            types.Int64    `tfsdk:"space_id"`
+	Email              types.String   `tfsdk:"email"`
+	Role               types.String   `tfsdk:"role"`
+	SpaceRoleIDs       []types.Int64  `tfsdk:"space_role_ids"`
+	Permissions        []types.String `tfsdk:"permissions"`
+	AllowMultipleRoles types.Bool     `tfsdk:"allow_multiple_roles"`
+	UserID             types.Int64    `tfsdk:"user_id"`
+	InvitationPending  types.Bool     `tfsdk:"invitation_pending"`
+}
+
+func (m *collaboratorResourceModel) toCreateInput() mapi.CollaboratorCreateInput {
+	return mapi.CollaboratorCreateInput{
+		Email:                      m.Email.ValueString(),
+		Role:                       m.Role.ValueStringPointer(),
+		SpaceRoleIds:               utils.ConvertToPointerIntSlice(m.SpaceRoleIDs),
+		Permissions:                utils.ConvertToPointerStringSlice(m.Permissions),
+		AllowMultipleRolesCreation: m.AllowMultipleRoles.ValueBool(),
+	}
+}
+
+func (m *collaboratorResourceModel) toUpdateInput() mapi.CollaboratorUpdateInput {
+	return mapi.CollaboratorUpdateInput{
+		Collaborator: mapi.CollaboratorBase{
+			Role:                       m.Role.ValueStringPointer(),
+			SpaceRoleIds:               utils.ConvertToPointerIntSlice(m.SpaceRoleIDs),
+			Permissions:                utils.ConvertToPointerStringSlice(m.Permissions),
+			AllowMultipleRolesCreation: m.AllowMultipleRoles.ValueBool(),
+		},
+	}
+}
+
+func (m *collaboratorResourceModel) fromRemote(spaceID int64, c *mapi.Collaborator) error {
+	if c == nil {
+		return fmt.Errorf("collaborator is nil")
+	}
+	m.ID = types.StringValue(utils.CreateIdentifier(spaceID, c.Id))
+	m.CollaboratorID = types.Int64Value(c.Id)
+	m.SpaceID = types.Int64Value(spaceID)
+	if email := c.Email(); email != "" && !strings.EqualFold(email, m.Email.ValueString()) {
+		m.Email = types.StringValue(email)
+	}
+	if !m.Role.IsNull() || len(c.SpaceRoleIds) == 0 {
+		m.Role = types.StringValue(c.Role)
+	}
+	m.SpaceRoleIDs = utils.RemoteInt64Slice(m.SpaceRoleIDs, c.SpaceRoleIds)
+	m.Permissions = utils.RemoteStringSlice(m.Permissions, c.Permissions)
+	m.UserID = types.Int64PointerValue(c.UserId)
+	m.InvitationPending = types.BoolValue(c.IsPending())
+	return nil
+}
@@ -0,0 +1,48 @@
+package collaborator
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+)
+
+func TestCollaboratorResourceModel_FromRemote(t *testing.T) {
+	userID := int64(99)
+	model := &collaboratorResourceModel{
+		Email:        types.StringValue("Jane@Example.com"),
+		SpaceRoleIDs: []types.Int64{types.Int64Value(74689)},
+	}
+
+	err := model.fromRemote(123, &mapi.Collaborator{
+		Id:           456,
+		UserId:       &userID,
+		Role:         "custom",
+		SpaceRoleIds: []int{74689},
+		Permissions:  []string{},
+		User:         &mapi.CollaboratorUser{Id: userID, Userid: "jane@example.com"},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "123/456", model.ID.ValueString())
+	assert.Equal(t, "Jane@Example.com", model.Email.ValueString(), "email casing should be kept")
+	assert.True(t, model.Role.IsNull(), "role should not be set when space roles are used")
+	assert.Nil(t, model.Permissions)
+	assert.Equal(t, int64(99), model.UserID.ValueInt64())
+	assert.False(t, model.InvitationPending.ValueBool())
+}
+
+func TestCollaboratorsResponse_FindCollaborator(t *testing.T) {
+	response := mapi.CollaboratorsResponse{
+		Collaborators: []mapi.Collaborator{
+			{Id: 1, User: &mapi.CollaboratorUser{Userid: "john@example.com"}},
+			{Id: 2, User: &mapi.CollaboratorUser{Userid: "jane@example.com"}},
+		},
+	}
+
+	assert.Equal(t, int64(1), response.FindCollaborator(1, "jane@example.com").Id)
+	assert.Equal(t, int64(2), response.FindCollaborator(3, "JANE@example.com").Id, "accepted invitation should be found by email")
+	assert.Nil(t, response.FindCollaborator(3, "other@example.com"))
+}
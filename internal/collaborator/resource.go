@@ -0,0 +1,297 @@
+package collaborator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &collaboratorResource{}
+	_ resource.ResourceWithConfigure      = &collaboratorResource{}
+	_ resource.ResourceWithImportState    = &collaboratorResource{}
+	_ resource.ResourceWithValidateConfig = &collaboratorResource{}
+)
+
+// NewCollaboratorResource is a helper function to simplify the provider implementation.
+func NewCollaboratorResource() resource.Resource {
+	return &collaboratorResource{}
+}
+
+// collaboratorResource is the resource implementation.
+type collaboratorResource struct {
+	client *mapi.Client
+}
+
+// Metadata returns the data source type name.
+func (r *collaboratorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collaborator"
+}
+
+// Schema defines the schema for the data source.
+func (r *collaboratorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Collaborators are the users with access to a space. Adding a collaborator with an email address " +
+			"that is not yet known to Storyblok sends an invitation. Use `role` for the built-in roles or " +
+			"`space_role_ids` to assign roles managed with `storyblok_space_role`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The terraform ID of the collaborator. This is a composite ID, " +
+					"and should not be used as reference",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collaborator_id": schema.Int64Attribute{
+				Description: "The ID of the collaborator.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": schema.Int64Attribute{
+				Description: "The ID of the space.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address of the collaborator.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The built-in role of the collaborator: `admin` or `editor`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("admin", "editor"),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("space_role_ids")),
+				},
+			},
+			"space_role_ids": schema.ListAttribute{
+				Description: "IDs of the space roles of the collaborator. Use the `role_id` of a " +
+					"`storyblok_space_role`. Assigning more than one role requires `allow_multiple_roles`.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
+			"permissions": schema.ListAttribute{
+				Description: "Additional permissions of the collaborator.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"allow_multiple_roles": schema.BoolAttribute{
+				Description: "Allow the collaborator to have multiple space roles; Default: false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"user_id": schema.Int64Attribute{
+				Description: "The ID of the user. Empty as long as the invitation is pending.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"invitation_pending": schema.BoolAttribute{
+				Description: "Whether the collaborator still has to accept the invitation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the combination of roles.
+func (r *collaboratorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config collaboratorResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(config.SpaceRoleIDs) > 1 && !config.AllowMultipleRoles.IsUnknown() && !config.AllowMultipleRoles.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("space_role_ids"),
+			"Multiple space roles not allowed",
+			fmt.Sprintf("%d space roles are assigned, set allow_multiple_roles to true to allow this.",
+				len(config.SpaceRoleIDs)),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *collaboratorResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = utils.GetAPIClient(req.ProviderData)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *collaboratorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan collaboratorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	input := plan.toCreateInput()
+	spaceID := plan.SpaceID.ValueInt64()
+
+	content, err := r.client.CreateCollaborator(ctx, spaceID, input)
+	if d := utils.CheckCreateError("collaborator", content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	collaborator := &content.JSON.Collaborator
+	tflog.Debug(ctx, spew.Sdump(collaborator))
+
+	// Map response body to schema and populate Computed attribute values
+	if err := plan.fromRemote(spaceID, collaborator); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating collaborator",
+			"Could not create collaborator, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *collaboratorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state collaboratorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceId, id := utils.ParseIdentifier(state.ID.ValueString())
+
+	// There is no endpoint to retrieve a single collaborator
+	content, err := r.client.ListCollaborators(ctx, spaceId)
+	if d := utils.CheckGetError("collaborator", id, content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	collaborator := content.JSON.FindCollaborator(id, state.Email.ValueString())
+	if collaborator == nil {
+		tflog.Warn(ctx, "Collaborator no longer exists, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	if err := state.fromRemote(spaceId, collaborator); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Storyblok Collaborator",
+			"Could not read Storyblok collaborator ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *collaboratorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan collaboratorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	input := plan.toUpdateInput()
+	spaceID := plan.SpaceID.ValueInt64()
+
+	content, err := r.client.UpdateCollaborator(ctx, spaceID, plan.CollaboratorID.ValueInt64(), input)
+	if d := utils.CheckUpdateError("collaborator", content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	collaborator := &content.JSON.Collaborator
+	tflog.Debug(ctx, spew.Sdump(collaborator))
+
+	// Map response body to schema and populate Computed attribute values
+	if err := plan.fromRemote(spaceID, collaborator); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating collaborator",
+			"Could not update collaborator, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *collaboratorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state collaboratorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceId, collaboratorId := utils.ParseIdentifier(state.ID.ValueString())
+	content, err := r.client.DeleteCollaborator(ctx, spaceId, collaboratorId)
+	if d := utils.CheckDeleteError("collaborator", content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+}
+
+func (r *collaboratorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
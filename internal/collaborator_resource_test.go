@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+func TestCollaboratorResourceBasic(t *testing.T) {
+	f, stop := ProviderFactories("./assets/collaborator")
+	defer func() {
+		_ = stop()
+	}()
+
+	id := "test"
+	rn := fmt.Sprintf("storyblok_collaborator.%s", id)
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config:      testCollaboratorConfig(id, spaceId, "[74689, 74690]", false),
+				ExpectError: regexp.MustCompile("set allow_multiple_roles to true"),
+			},
+			{
+				Config: testCollaboratorConfig(id, spaceId, "[74689]", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "email", "jane@example.com"),
+					resource.TestCheckResourceAttr(rn, "space_role_ids.#", "1"),
+					resource.TestCheckResourceAttr(rn, "space_role_ids.0", "74689"),
+					resource.TestCheckResourceAttr(rn, "invitation_pending", "true"),
+					resource.TestCheckNoResourceAttr(rn, "role"),
+					resource.TestCheckNoResourceAttr(rn, "user_id"),
+				),
+			},
+			{
+				Config: testCollaboratorConfig(id, spaceId, "[74689, 74690]", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "space_role_ids.#", "2"),
+					resource.TestCheckResourceAttr(rn, "allow_multiple_roles", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testCollaboratorConfig(identifier string, spaceId int, spaceRoleIds string, allowMultipleRoles bool) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_collaborator" "{{ .identifier }}" {
+		  space_id             = {{ .spaceId }}
+		  email                = "jane@example.com"
+		  space_role_ids       = {{ .spaceRoleIds }}
+		  allow_multiple_roles = {{ .allowMultipleRoles }}
+		}
+	`, map[string]any{
+		"identifier":         identifier,
+		"spaceId":            spaceId,
+		"spaceRoleIds":       spaceRoleIds,
+		"allowMultipleRoles": allowMultipleRoles,
+	})
+}
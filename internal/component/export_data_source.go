@@ -0,0 +1,114 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/storyblok-go-sdk/sbmgmt"
+
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &componentsExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &componentsExportDataSource{}
+)
+
+// NewComponentsExportDataSource is a helper function to simplify the provider implementation.
+func NewComponentsExportDataSource() datasource.DataSource {
+	return &componentsExportDataSource{}
+}
+
+// componentsExportDataSource is the data source implementation.
+type componentsExportDataSource struct {
+	client sbmgmt.ClientWithResponsesInterface
+}
+
+// Metadata returns the data source type name.
+func (d *componentsExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_components_export"
+}
+
+// Schema defines the schema for the data source.
+func (d *componentsExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Renders the components of a space in the JSON format of the Storyblok CLI `pull-components` " +
+			"command, for example to generate types with `storyblok-generate-ts`. Add the component resources " +
+			"to `depends_on` to include the components which are planned in the same run, the data source is " +
+			"then read after the components are applied.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the space as string.",
+				Computed:    true,
+			},
+			"space_id": schema.Int64Attribute{
+				Description: "The ID of the space.",
+				Required:    true,
+			},
+			"names": schema.ListAttribute{
+				Description: "Names of the components to export. All components of the space are exported " +
+					"when not set.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"json": schema.StringAttribute{
+				Description: "The components as JSON, in the same format as the `components.<space>.json` " +
+					"file written by the Storyblok CLI.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *componentsExportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = utils.GetClient(req.ProviderData)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *componentsExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state componentsExportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := state.SpaceID.ValueInt64()
+
+	content, err := d.client.ListComponentsWithResponse(ctx, spaceID)
+	if diag := utils.CheckGetError("components of space", spaceID, content, err); diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	// The components are read from the response body, so the properties the
+	// SDK doesn't know about are exported as well
+	value, missing, err := renderComponentsExport(content.Body, state.Names)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error exporting components",
+			fmt.Sprintf("Could not export the components of space %d: %s", spaceID, err.Error()),
+		)
+		return
+	}
+	if len(missing) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Components not found",
+			fmt.Sprintf("The following components do not exist in space %d: %s", spaceID, strings.Join(missing, ", ")),
+		)
+	}
+
+	state.ID = types.StringValue(fmt.Sprint(spaceID))
+	state.JSON = types.StringValue(value)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
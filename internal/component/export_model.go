@@ -0,0 +1,67 @@
+package component
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// componentsExportDataSourceModel maps the data source schema data.
+type componentsExportDataSourceModel struct {
+	ID      types.String   `tfsdk:"id"`
+	SpaceID types.Int64    `tfsdk:"space_id"`
+	Names   []types.String `tfsdk:"names"`
+	JSON    types.String   `tfsdk:"json"`
+}
+
+// renderComponentsExport renders the components in the list response in the
+// format of the Storyblok CLI pull-components command, keeping the properties
+// and their order as returned by Storyblok. When names are given only those
+// components are rendered, and the names which are not found are returned.
+func renderComponentsExport(body []byte, names []types.String) (string, []string, error) {
+	var response struct {
+		Components []json.RawMessage `json:"components"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", nil, err
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range names {
+		wanted[name.ValueString()] = true
+	}
+
+	components := make([]json.RawMessage, 0, len(response.Components))
+	found := map[string]bool{}
+	for _, component := range response.Components {
+		var properties struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(component, &properties); err != nil {
+			return "", nil, err
+		}
+		if len(wanted) > 0 && !wanted[properties.Name] {
+			continue
+		}
+		found[properties.Name] = true
+		components = append(components, component)
+	}
+
+	var missing []string
+	for _, name := range names {
+		if !found[name.ValueString()] {
+			missing = append(missing, name.ValueString())
+		}
+	}
+
+	// The CLI writes the JSON indented with two spaces, without escaping HTML
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(map[string]any{"components": components}); err != nil {
+		return "", nil, err
+	}
+	return buffer.String(), missing, nil
+}
@@ -0,0 +1,52 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderComponentsExport(t *testing.T) {
+	body := []byte(`{"components":[` +
+		`{"name":"page","id":1,"schema":{"title":{"type":"text","pos":0},"body":{"type":"bloks","pos":1}},"preview_tmpl":"<b>{{ it.title }}</b>"},` +
+		`{"name":"teaser","id":2,"schema":{}}]}`)
+
+	value, missing, err := renderComponentsExport(body, nil)
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+	assert.Equal(t, `{
+  "components": [
+    {
+      "name": "page",
+      "id": 1,
+      "schema": {
+        "title": {
+          "type": "text",
+          "pos": 0
+        },
+        "body": {
+          "type": "bloks",
+          "pos": 1
+        }
+      },
+      "preview_tmpl": "<b>{{ it.title }}</b>"
+    },
+    {
+      "name": "teaser",
+      "id": 2,
+      "schema": {}
+    }
+  ]
+}
+`, value)
+
+	value, missing, err = renderComponentsExport(body, []types.String{
+		types.StringValue("teaser"),
+		types.StringValue("unknown"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"unknown"}, missing)
+	assert.JSONEq(t, `{"components":[{"name":"teaser","id":2,"schema":{}}]}`, value)
+}
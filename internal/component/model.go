@@ -1,31 +1,46 @@
 package component
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/labd/storyblok-go-sdk/sbmgmt"
+	orderedmap "github.com/wk8/go-ordered-map/v2"
 
 	"github.com/labd/terraform-provider-storyblok/internal/utils"
 )
 
 // componentResourceModel maps the resource schema data.
 type componentResourceModel struct {
-	ID                 types.String          `tfsdk:"id"`
-	ComponentID        types.Int64           `tfsdk:"component_id"`
-	SpaceID            types.Int64           `tfsdk:"space_id"`
-	CreatedAt          types.String          `tfsdk:"created_at"`
-	DisplayName        types.String          `tfsdk:"display_name"`
-	Color              types.String          `tfsdk:"color"`
-	Icon               types.String          `tfsdk:"icon"`
-	Image              types.String          `tfsdk:"image"`
-	PreviewTmpl        types.String          `tfsdk:"preview_tmpl"`
-	PreviewField       types.String          `tfsdk:"preview_field"`
-	Name               types.String          `tfsdk:"name"`
-	IsRoot             types.Bool            `tfsdk:"is_root"`
-	IsNestable         types.Bool            `tfsdk:"is_nestable"`
-	ComponentGroupUUID types.String          `tfsdk:"component_group_uuid"`
-	Schema             map[string]fieldModel `tfsdk:"schema"`
+	ID                     types.String          `tfsdk:"id"`
+	ComponentID            types.Int64           `tfsdk:"component_id"`
+	SpaceID                types.Int64           `tfsdk:"space_id"`
+	CreatedAt              types.String          `tfsdk:"created_at"`
+	DisplayName            types.String          `tfsdk:"display_name"`
+	Color                  types.String          `tfsdk:"color"`
+	Icon                   types.String          `tfsdk:"icon"`
+	Image                  types.String          `tfsdk:"image"`
+	PreviewTmpl            types.String          `tfsdk:"preview_tmpl"`
+	PreviewField           types.String          `tfsdk:"preview_field"`
+	Name                   types.String          `tfsdk:"name"`
+	IsRoot                 types.Bool            `tfsdk:"is_root"`
+	IsNestable             types.Bool            `tfsdk:"is_nestable"`
+	ComponentGroupUUID     types.String          `tfsdk:"component_group_uuid"`
+	Schema                 map[string]fieldModel `tfsdk:"schema"`
+	Fields                 []listFieldModel      `tfsdk:"fields"`
+	Tabs                   []tabModel            `tfsdk:"tabs"`
+	Sections               []tabModel            `tfsdk:"sections"`
+	DeletionProtection     types.Bool            `tfsdk:"deletion_protection"`
+	ProtectFields          types.Bool            `tfsdk:"protect_fields"`
+	PublishMigratedStories types.Bool            `tfsdk:"publish_migrated_stories"`
+	ExtraJSON              types.String          `tfsdk:"extra_json"`
 }
 
 type fieldModel struct {
@@ -95,6 +110,22 @@ type fieldModel struct {
 	IsReferenceType         types.Bool                 `tfsdk:"is_reference_type"`
 	MaxValue                types.Int64                `tfsdk:"max_value"`
 	MinValue                types.Int64                `tfsdk:"min_value"`
+	RenamedFrom             types.String               `tfsdk:"renamed_from"`
+	ExtraJSON               types.String               `tfsdk:"extra_json"`
+}
+
+// listFieldModel is a field in the ordered list of fields, which has a name
+// instead of a key in the schema.
+type listFieldModel struct {
+	Name types.String `tfsdk:"name"`
+	fieldModel
+}
+
+// tabModel is a tab or a section, which groups fields in the editor.
+type tabModel struct {
+	Key         types.String   `tfsdk:"key"`
+	DisplayName types.String   `tfsdk:"display_name"`
+	Fields      []types.String `tfsdk:"fields"`
 }
 
 type conditionalSettingsModel struct {
@@ -124,15 +155,9 @@ type optionModel struct {
 }
 
 func (m *componentResourceModel) toRemoteInput() sbmgmt.ComponentCreateInput {
-	raw := make(map[string]sbmgmt.FieldInput, len(m.Schema))
-	for name := range m.Schema {
-		item := m.Schema[name]
-		raw[name] = toFieldInput(item)
-	}
-
 	// Sort the fields by position. Storyblok has Int64ToStringInterfacePointer position field but ends up
 	// using the ordering of the json...
-	schema := utils.SortComponentFields(raw)
+	schema := utils.SortComponentFields(m.toFieldInputs())
 
 	componentGroupUuid := utils.AsUUIDPointer(m.ComponentGroupUUID)
 
@@ -153,15 +178,9 @@ func (m *componentResourceModel) toRemoteInput() sbmgmt.ComponentCreateInput {
 	}
 }
 func (m *componentResourceModel) toUpdateInput() sbmgmt.ComponentUpdateInput {
-	raw := make(map[string]sbmgmt.FieldInput, len(m.Schema))
-	for name := range m.Schema {
-		item := m.Schema[name]
-		raw[name] = toFieldInput(item)
-	}
-
 	// Sort the fields by position. Storyblok has Int64ToStringInterfacePointer position field but ends up
 	// using the ordering of the json...
-	schema := utils.SortComponentFields(raw)
+	schema := utils.SortComponentFields(m.toFieldInputs())
 
 	componentGroupUuid := utils.AsUUIDPointer(m.ComponentGroupUUID)
 
@@ -182,6 +201,337 @@ func (m *componentResourceModel) toUpdateInput() sbmgmt.ComponentUpdateInput {
 	}
 }
 
+// toRequestBody returns the body of the request to create or update the
+// component. The extra_json of the component and of its fields is deep-merged
+// into the input, so settings which are not supported by the SDK can be set.
+func (m *componentResourceModel) toRequestBody(input sbmgmt.ComponentCreateInput) ([]byte, error) {
+	fields := m.fieldSchema()
+	schema := orderedmap.New[string, json.RawMessage]()
+	if input.Component.Schema != nil {
+		for pair := input.Component.Schema.Oldest(); pair != nil; pair = pair.Next() {
+			field, err := mergeExtraJSON(pair.Value, fields[pair.Key].ExtraJSON)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", pair.Key, err)
+			}
+			raw, err := json.Marshal(field)
+			if err != nil {
+				return nil, err
+			}
+			schema.Set(pair.Key, raw)
+		}
+	}
+
+	base := input.Component
+	base.Schema = nil
+	component, err := mergeExtraJSON(base, m.ExtraJSON)
+	if err != nil {
+		return nil, err
+	}
+	component["schema"] = schema
+
+	return json.Marshal(map[string]any{"component": component})
+}
+
+// mergeExtraJSON returns the value as a JSON object with the extra JSON
+// deep-merged into it.
+func mergeExtraJSON(value any, extra types.String) (map[string]any, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	result, err := decodeJSONObject(raw)
+	if err != nil {
+		return nil, err
+	}
+	if extra.IsNull() || extra.IsUnknown() {
+		return result, nil
+	}
+
+	object, err := decodeJSONObject([]byte(extra.ValueString()))
+	if err != nil {
+		return nil, fmt.Errorf("invalid extra_json: %w", err)
+	}
+	return utils.MergeJSON(result, object), nil
+}
+
+// unmodelledFieldKeys are the keys of the field input which have no
+// attribute, so they can be set with the extra_json of the field.
+var unmodelledFieldKeys = []string{"required_fields", "style_options"}
+
+// modelledKeys returns the JSON keys of the input which are set from the
+// attributes of the resource, and can't be set with extra_json, as the value
+// which is read back would differ from the configuration.
+func modelledKeys(input any, exclude ...string) []string {
+	var result []string
+	inputType := reflect.TypeOf(input)
+	for i := 0; i < inputType.NumField(); i++ {
+		name, _, _ := strings.Cut(inputType.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" && !pie.Contains(exclude, name) {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// decodeJSONObject decodes a JSON object, keeping numbers as they are.
+func decodeJSONObject(raw []byte) (map[string]any, error) {
+	var result map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// extraFromRemote reads back the keys of the extra_json of the component and
+// of its fields from the response body, since the SDK drops the properties it
+// doesn't know about.
+func (m *componentResourceModel) extraFromRemote(body []byte) error {
+	var response struct {
+		Component map[string]any `json:"component"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return err
+	}
+
+	var err error
+	if m.ExtraJSON, err = projectExtraJSON(m.ExtraJSON, response.Component); err != nil {
+		return err
+	}
+
+	schema, _ := response.Component["schema"].(map[string]any)
+	for i := range m.Fields {
+		field := &m.Fields[i]
+		if field.ExtraJSON, err = projectExtraJSON(field.ExtraJSON, schema[field.Name.ValueString()]); err != nil {
+			return err
+		}
+	}
+	for name, field := range m.Schema {
+		if field.ExtraJSON, err = projectExtraJSON(field.ExtraJSON, schema[name]); err != nil {
+			return err
+		}
+		m.Schema[name] = field
+	}
+	return nil
+}
+
+// projectExtraJSON returns the remote values of the keys which are set in the
+// extra JSON. The extra JSON is kept as is when it is equal to the remote
+// values, so formatting differences don't result in a diff.
+func projectExtraJSON(extra types.String, remote any) (types.String, error) {
+	if extra.IsNull() || extra.IsUnknown() {
+		return extra, nil
+	}
+
+	var set any
+	if err := json.Unmarshal([]byte(extra.ValueString()), &set); err != nil {
+		return extra, fmt.Errorf("invalid extra_json: %w", err)
+	}
+	value, err := json.Marshal(utils.ProjectJSON(set, remote))
+	if err != nil {
+		return extra, err
+	}
+	if utils.EqualJSON(extra.ValueString(), string(value)) {
+		return extra, nil
+	}
+	return types.StringValue(string(value)), nil
+}
+
+// toFieldInputs returns the fields of the schema together with the tabs and
+// sections. The sections which are not part of a tab are placed after the
+// other fields, followed by the tabs, in the declared order. Each tab or
+// section is followed by its fields, so the positions of the fields in tabs
+// and sections follow from their order.
+func (m *componentResourceModel) toFieldInputs() map[string]sbmgmt.FieldInput {
+	fields := m.fieldSchema()
+	raw := make(map[string]sbmgmt.FieldInput, len(fields)+len(m.Tabs)+len(m.Sections))
+	for name := range fields {
+		item := fields[name]
+		raw[name] = toFieldInput(item)
+	}
+
+	position := int64(0)
+	grouped := m.groupedFields()
+	for name, field := range fields {
+		if !grouped[name] && field.Position.ValueInt64() > position {
+			position = field.Position.ValueInt64()
+		}
+	}
+
+	sections := map[string]tabModel{}
+	for _, section := range m.Sections {
+		sections[section.Key.ValueString()] = section
+	}
+
+	var place func(fieldType string, group tabModel)
+	place = func(fieldType string, group tabModel) {
+		position++
+		raw[group.Key.ValueString()] = sbmgmt.FieldInput{
+			Type:        fieldType,
+			Pos:         position,
+			DisplayName: group.DisplayName.ValueStringPointer(),
+			Keys:        utils.ConvertToPointerStringSlice(group.Fields),
+		}
+
+		for _, key := range group.Fields {
+			if section, ok := sections[key.ValueString()]; ok && fieldType == "tab" {
+				place("section", section)
+				continue
+			}
+			field, ok := raw[key.ValueString()]
+			if !ok {
+				continue
+			}
+			position++
+			field.Pos = position
+			raw[key.ValueString()] = field
+		}
+	}
+
+	inTab := m.tabbedFields()
+	for _, section := range m.Sections {
+		if !inTab[section.Key.ValueString()] {
+			place("section", section)
+		}
+	}
+	for _, tab := range m.Tabs {
+		place("tab", tab)
+	}
+
+	return raw
+}
+
+// fieldSchema returns the fields by key, either from the schema or from the
+// ordered list of fields. The positions of the fields in the list follow from
+// their order.
+func (m *componentResourceModel) fieldSchema() map[string]fieldModel {
+	if m.Fields == nil {
+		return m.Schema
+	}
+
+	grouped := m.groupedFields()
+	result := make(map[string]fieldModel, len(m.Fields))
+	position := int64(0)
+	for _, field := range m.Fields {
+		if field.Name.IsUnknown() {
+			continue
+		}
+
+		item := field.fieldModel
+		if grouped[field.Name.ValueString()] {
+			item.Position = types.Int64Null()
+		} else {
+			position++
+			item.Position = types.Int64Value(position)
+		}
+		result[field.Name.ValueString()] = item
+	}
+	return result
+}
+
+// setImpliedPositions sets the positions of the ordered list of fields.
+func (m *componentResourceModel) setImpliedPositions() {
+	fields := m.fieldSchema()
+	for i := range m.Fields {
+		if field, ok := fields[m.Fields[i].Name.ValueString()]; ok {
+			m.Fields[i].Position = field.Position
+		}
+	}
+}
+
+// fieldsPath returns the path of the fields, either the schema or the ordered
+// list of fields.
+func (m *componentResourceModel) fieldsPath() path.Path {
+	if m.Fields != nil {
+		return path.Root("fields")
+	}
+	return path.Root("schema")
+}
+
+// fieldPath returns the path of the field with the key.
+func (m *componentResourceModel) fieldPath(name string) path.Path {
+	if m.Fields == nil {
+		return path.Root("schema").AtMapKey(name)
+	}
+	for i, field := range m.Fields {
+		if field.Name.ValueString() == name {
+			return path.Root("fields").AtListIndex(i)
+		}
+	}
+	return path.Root("fields")
+}
+
+// toListFields returns the fields as an ordered list. Fields keep the order of
+// the current list, other fields are added in the order of their position.
+func toListFields(fields map[string]fieldModel, current []listFieldModel) []listFieldModel {
+	result := make([]listFieldModel, 0, len(fields))
+	added := map[string]bool{}
+	for _, field := range current {
+		name := field.Name.ValueString()
+		if item, ok := fields[name]; ok && !added[name] {
+			result = append(result, listFieldModel{Name: field.Name, fieldModel: item})
+			added[name] = true
+		}
+	}
+
+	remaining := []string{}
+	for name := range fields {
+		if !added[name] {
+			remaining = append(remaining, name)
+		}
+	}
+	sort.Slice(remaining, func(i, j int) bool {
+		a, b := fields[remaining[i]].Position, fields[remaining[j]].Position
+		if a.IsNull() != b.IsNull() {
+			return b.IsNull()
+		}
+		if a.ValueInt64() != b.ValueInt64() {
+			return a.ValueInt64() < b.ValueInt64()
+		}
+		return remaining[i] < remaining[j]
+	})
+	for _, name := range remaining {
+		result = append(result, listFieldModel{Name: types.StringValue(name), fieldModel: fields[name]})
+	}
+	return result
+}
+
+// isGroup returns whether the remote field is one of the tabs or sections of
+// the component. Tab and section fields which are managed as fields stay
+// fields.
+func isGroup(current map[string]fieldModel, name string, field sbmgmt.FieldInput) bool {
+	if field.Type != "tab" && field.Type != "section" {
+		return false
+	}
+	_, isField := current[name]
+	return !isField
+}
+
+// tabbedFields returns the keys of the fields and sections which are part of a
+// tab.
+func (m *componentResourceModel) tabbedFields() map[string]bool {
+	result := map[string]bool{}
+	for _, tab := range m.Tabs {
+		for _, key := range tab.Fields {
+			result[key.ValueString()] = true
+		}
+	}
+	return result
+}
+
+// groupedFields returns the keys of the fields which are part of a tab or a
+// section.
+func (m *componentResourceModel) groupedFields() map[string]bool {
+	result := m.tabbedFields()
+	for _, section := range m.Sections {
+		for _, key := range section.Fields {
+			result[key.ValueString()] = true
+		}
+	}
+	return result
+}
+
 func toFieldInput(item fieldModel) sbmgmt.FieldInput {
 	return sbmgmt.FieldInput{
 		Type: item.Type.ValueString(),
@@ -273,20 +623,91 @@ func (m *componentResourceModel) fromRemote(spaceID int64, c *sbmgmt.Component)
 		m.Icon = types.StringValue(string(*c.Icon))
 	}
 
+	current := m.fieldSchema()
+	tabs := toGroups(current, c.Schema, "tab")
+	if len(tabs) > 0 || m.Tabs != nil {
+		m.Tabs = tabs
+	}
+	sections := toGroups(current, c.Schema, "section")
+	if len(sections) > 0 || m.Sections != nil {
+		m.Sections = sections
+	}
+	grouped := m.groupedFields()
+
 	schema := make(map[string]fieldModel, c.Schema.Len())
 	for pair := c.Schema.Oldest(); pair != nil; pair = pair.Next() {
 		name := pair.Key
 		field := pair.Value
+		if isGroup(current, name, field) {
+			continue
+		}
 
 		schema[name], err = toFieldModel(field)
 		if err != nil {
 			return err
 		}
+
+		// renamed_from is not stored in Storyblok, extra_json is read back
+		// from the response body
+		if previous, ok := current[name]; ok {
+			item := schema[name]
+			item.RenamedFrom = previous.RenamedFrom
+			item.ExtraJSON = previous.ExtraJSON
+			schema[name] = item
+		}
+
+		// The position of fields in tabs and sections follows from their order
+		if grouped[name] {
+			item := schema[name]
+			item.Position = types.Int64Null()
+			schema[name] = item
+		}
+	}
+	if m.Fields != nil {
+		m.Fields = toListFields(schema, m.Fields)
+	} else {
+		m.Schema = schema
+	}
+	if m.DeletionProtection.IsNull() || m.DeletionProtection.IsUnknown() {
+		m.DeletionProtection = types.BoolValue(true)
+	}
+	if m.PublishMigratedStories.IsNull() || m.PublishMigratedStories.IsUnknown() {
+		m.PublishMigratedStories = types.BoolValue(false)
 	}
-	m.Schema = schema
 	return nil
 }
 
+// toGroups returns the remote tabs or sections of the type, in the order of
+// their position.
+func toGroups(current map[string]fieldModel, schema *orderedmap.OrderedMap[string, sbmgmt.FieldInput], fieldType string) []tabModel {
+	fields := []sbmgmt.FieldInput{}
+	keys := []string{}
+	for pair := schema.Oldest(); pair != nil; pair = pair.Next() {
+		if pair.Value.Type == fieldType && isGroup(current, pair.Key, pair.Value) {
+			fields = append(fields, pair.Value)
+			keys = append(keys, pair.Key)
+		}
+	}
+
+	order := make([]int, len(fields))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return fields[order[i]].Pos < fields[order[j]].Pos
+	})
+
+	groups := make([]tabModel, 0, len(order))
+	for _, i := range order {
+		groups = append(groups, tabModel{
+			Key:         types.StringValue(keys[i]),
+			DisplayName: utils.FromStringPointer(fields[i].DisplayName),
+			Fields:      utils.ConvertToStringSlice(fields[i].Keys),
+		})
+	}
+	return groups
+}
+
 func toFieldModel(field sbmgmt.FieldInput) (fieldModel, error) {
 	maxOptions, err := utils.InterfacePointerToInt64(field.MaxOptions)
 	if err != nil {
@@ -573,3 +994,75 @@ func deserializeRuleConditions(ruleConditions []ruleConditionModel) *[]sbmgmt.Ru
 
 	return &deserializedRuleConditions
 }
+
+// fieldRenames returns the fields renamed since the previous schema, mapping
+// the old key to the new key.
+func (m *componentResourceModel) fieldRenames(previous map[string]fieldModel) map[string]string {
+	fields := m.fieldSchema()
+	renames := map[string]string{}
+	for name, field := range fields {
+		from := field.RenamedFrom.ValueString()
+		if from == "" {
+			continue
+		}
+		if _, ok := previous[from]; !ok {
+			continue
+		}
+		if _, ok := fields[from]; ok {
+			continue
+		}
+		renames[from] = name
+	}
+	return renames
+}
+
+// fieldChanges describes the changes of the fields compared to the previous
+// schema which lose content in stories.
+type fieldChanges struct {
+	Removed      []string
+	TypeChanges  map[string][2]string
+	RenamedTypes map[string][2]string
+}
+
+func (m *componentResourceModel) fieldChanges(previous map[string]fieldModel) fieldChanges {
+	fields := m.fieldSchema()
+	renames := m.fieldRenames(previous)
+	changes := fieldChanges{
+		TypeChanges:  map[string][2]string{},
+		RenamedTypes: map[string][2]string{},
+	}
+
+	for name, field := range previous {
+		current, ok := fields[name]
+		if !ok {
+			if to, renamed := renames[name]; renamed {
+				current = fields[to]
+				if !current.Type.IsUnknown() && !current.Type.Equal(field.Type) {
+					changes.RenamedTypes[to] = [2]string{field.Type.ValueString(), current.Type.ValueString()}
+				}
+				continue
+			}
+			// Tabs and sections don't hold content
+			if t := field.Type.ValueString(); t != "tab" && t != "section" {
+				changes.Removed = append(changes.Removed, name)
+			}
+			continue
+		}
+		if !current.Type.IsUnknown() && !current.Type.Equal(field.Type) {
+			changes.TypeChanges[name] = [2]string{field.Type.ValueString(), current.Type.ValueString()}
+		}
+	}
+	sort.Strings(changes.Removed)
+	return changes
+}
+
+// hasComponentReferences returns whether any field refers to other
+// components.
+func (m *componentResourceModel) hasComponentReferences() bool {
+	for _, field := range m.fieldSchema() {
+		if len(field.ComponentWhitelist) > 0 || len(field.FilterContentType) > 0 {
+			return true
+		}
+	}
+	return false
+}
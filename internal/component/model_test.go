@@ -0,0 +1,329 @@
+package component
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/storyblok-go-sdk/sbmgmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	orderedmap "github.com/wk8/go-ordered-map/v2"
+
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+func TestComponentResourceModel_FieldChanges(t *testing.T) {
+	previous := map[string]fieldModel{
+		"headline": {Type: types.StringValue("text")},
+		"intro":    {Type: types.StringValue("text")},
+		"image":    {Type: types.StringValue("asset")},
+		"count":    {Type: types.StringValue("text")},
+		"link":     {Type: types.StringValue("text")},
+	}
+	model := &componentResourceModel{
+		Schema: map[string]fieldModel{
+			"title":  {Type: types.StringValue("text"), RenamedFrom: types.StringValue("headline")},
+			"image":  {Type: types.StringValue("asset")},
+			"count":  {Type: types.StringValue("number")},
+			"url":    {Type: types.StringValue("multilink"), RenamedFrom: types.StringValue("link")},
+			"author": {Type: types.StringValue("text"), RenamedFrom: types.StringValue("unknown")},
+		},
+	}
+
+	assert.Equal(t, map[string]string{"headline": "title", "link": "url"}, model.fieldRenames(previous))
+
+	changes := model.fieldChanges(previous)
+	assert.Equal(t, []string{"intro"}, changes.Removed)
+	assert.Equal(t, map[string][2]string{"count": {"text", "number"}}, changes.TypeChanges)
+	assert.Equal(t, map[string][2]string{"url": {"text", "multilink"}}, changes.RenamedTypes)
+}
+
+func TestComponentResourceModel_FieldRenamesApplied(t *testing.T) {
+	previous := map[string]fieldModel{
+		"title": {Type: types.StringValue("text")},
+	}
+	model := &componentResourceModel{
+		Schema: map[string]fieldModel{
+			"title": {Type: types.StringValue("text"), RenamedFrom: types.StringValue("headline")},
+		},
+	}
+
+	assert.Empty(t, model.fieldRenames(previous), "renames which are applied should not migrate again")
+	assert.Empty(t, model.fieldChanges(previous).Removed)
+}
+
+func TestComponentResourceModel_ToFieldInputsTabs(t *testing.T) {
+	model := &componentResourceModel{
+		Schema: map[string]fieldModel{
+			"title":    {Type: types.StringValue("text"), Position: types.Int64Value(1)},
+			"intro":    {Type: types.StringValue("text"), Position: types.Int64Value(2)},
+			"seo":      {Type: types.StringValue("text")},
+			"image":    {Type: types.StringValue("asset")},
+			"settings": {Type: types.StringValue("boolean")},
+		},
+		Tabs: []tabModel{
+			{
+				Key:         types.StringValue("tab-media"),
+				DisplayName: types.StringValue("Media"),
+				Fields:      []types.String{types.StringValue("image")},
+			},
+			{
+				Key:    types.StringValue("tab-seo"),
+				Fields: []types.String{types.StringValue("settings"), types.StringValue("seo")},
+			},
+		},
+	}
+
+	schema := utils.SortComponentFields(model.toFieldInputs())
+
+	keys := []string{}
+	for pair := schema.Oldest(); pair != nil; pair = pair.Next() {
+		keys = append(keys, pair.Key)
+	}
+	assert.Equal(t, []string{"title", "intro", "tab-media", "image", "tab-seo", "settings", "seo"}, keys)
+
+	tab, _ := schema.Get("tab-seo")
+	assert.Equal(t, "tab", tab.Type)
+	assert.Equal(t, int64(5), tab.Pos)
+	assert.Equal(t, []string{"settings", "seo"}, *tab.Keys)
+	assert.Nil(t, tab.DisplayName)
+
+	seo, _ := schema.Get("seo")
+	assert.Equal(t, int64(7), seo.Pos)
+}
+
+func TestComponentResourceModel_FromRemoteTabs(t *testing.T) {
+	schema := orderedmap.New[string, sbmgmt.FieldInput]()
+	schema.Set("image", sbmgmt.FieldInput{Type: "asset", Pos: 4})
+	schema.Set("tab-seo", sbmgmt.FieldInput{Type: "tab", Pos: 5, Keys: &[]string{"seo"}})
+	schema.Set("tab-media", sbmgmt.FieldInput{Type: "tab", Pos: 3, Keys: &[]string{"image"}, DisplayName: types.StringValue("Media").ValueStringPointer()})
+	schema.Set("seo", sbmgmt.FieldInput{Type: "text", Pos: 6})
+	schema.Set("layout", sbmgmt.FieldInput{Type: "tab", Pos: 2, Keys: &[]string{}})
+	schema.Set("title", sbmgmt.FieldInput{Type: "text", Pos: 1})
+
+	model := &componentResourceModel{
+		Schema: map[string]fieldModel{
+			"layout": {Type: types.StringValue("tab")},
+		},
+	}
+	err := model.fromRemote(1, &sbmgmt.Component{Id: 2, Schema: schema})
+	require.NoError(t, err)
+
+	assert.Equal(t, []tabModel{
+		{
+			Key:         types.StringValue("tab-media"),
+			DisplayName: types.StringValue("Media"),
+			Fields:      []types.String{types.StringValue("image")},
+		},
+		{
+			Key:         types.StringValue("tab-seo"),
+			DisplayName: types.StringNull(),
+			Fields:      []types.String{types.StringValue("seo")},
+		},
+	}, model.Tabs)
+	assert.Equal(t, []string{"image", "layout", "seo", "title"}, utils.SortedKeys(model.Schema))
+	assert.True(t, model.Schema["image"].Position.IsNull())
+	assert.True(t, model.Schema["seo"].Position.IsNull())
+	assert.Equal(t, int64(1), model.Schema["title"].Position.ValueInt64())
+	assert.Equal(t, "tab", model.Schema["layout"].Type.ValueString(), "tabs in the schema should stay in the schema")
+}
+
+func TestComponentResourceModel_ToFieldInputsSections(t *testing.T) {
+	model := &componentResourceModel{
+		Schema: map[string]fieldModel{
+			"title":       {Type: types.StringValue("text"), Position: types.Int64Value(1)},
+			"author":      {Type: types.StringValue("text")},
+			"date":        {Type: types.StringValue("datetime")},
+			"seo_title":   {Type: types.StringValue("text")},
+			"description": {Type: types.StringValue("textarea")},
+		},
+		Sections: []tabModel{
+			{
+				Key:         types.StringValue("section-meta"),
+				DisplayName: types.StringValue("Meta"),
+				Fields:      []types.String{types.StringValue("author"), types.StringValue("date")},
+			},
+			{
+				Key:    types.StringValue("section-search"),
+				Fields: []types.String{types.StringValue("description")},
+			},
+		},
+		Tabs: []tabModel{
+			{
+				Key:    types.StringValue("tab-seo"),
+				Fields: []types.String{types.StringValue("seo_title"), types.StringValue("section-search")},
+			},
+		},
+	}
+
+	schema := utils.SortComponentFields(model.toFieldInputs())
+
+	keys := []string{}
+	for pair := schema.Oldest(); pair != nil; pair = pair.Next() {
+		keys = append(keys, pair.Key)
+	}
+	assert.Equal(t, []string{
+		"title", "section-meta", "author", "date", "tab-seo", "seo_title", "section-search", "description",
+	}, keys)
+
+	section, _ := schema.Get("section-meta")
+	assert.Equal(t, "section", section.Type)
+	assert.Equal(t, int64(2), section.Pos)
+	assert.Equal(t, []string{"author", "date"}, *section.Keys)
+	assert.Equal(t, "Meta", *section.DisplayName)
+
+	section, _ = schema.Get("section-search")
+	assert.Equal(t, "section", section.Type)
+	assert.Equal(t, int64(7), section.Pos)
+
+	tab, _ := schema.Get("tab-seo")
+	assert.Equal(t, []string{"seo_title", "section-search"}, *tab.Keys)
+}
+
+func TestComponentResourceModel_FromRemoteSections(t *testing.T) {
+	schema := orderedmap.New[string, sbmgmt.FieldInput]()
+	schema.Set("title", sbmgmt.FieldInput{Type: "text", Pos: 1})
+	schema.Set("section-c", sbmgmt.FieldInput{Type: "section", Pos: 6, Keys: &[]string{"c"}})
+	schema.Set("section-a", sbmgmt.FieldInput{Type: "section", Pos: 2, Keys: &[]string{"a"}})
+	schema.Set("section-b", sbmgmt.FieldInput{Type: "section", Pos: 4, Keys: &[]string{"b"}})
+	schema.Set("a", sbmgmt.FieldInput{Type: "text", Pos: 3})
+	schema.Set("b", sbmgmt.FieldInput{Type: "text", Pos: 5})
+	schema.Set("c", sbmgmt.FieldInput{Type: "text", Pos: 7})
+
+	model := &componentResourceModel{}
+	err := model.fromRemote(1, &sbmgmt.Component{Id: 2, Schema: schema})
+	require.NoError(t, err)
+
+	keys := []string{}
+	for _, section := range model.Sections {
+		keys = append(keys, section.Key.ValueString())
+	}
+	assert.Equal(t, []string{"section-a", "section-b", "section-c"}, keys)
+	assert.Nil(t, model.Tabs)
+	assert.Equal(t, []string{"a", "b", "c", "title"}, utils.SortedKeys(model.Schema))
+	assert.True(t, model.Schema["a"].Position.IsNull())
+	assert.Equal(t, int64(1), model.Schema["title"].Position.ValueInt64())
+}
+
+func TestComponentResourceModel_FieldSchemaList(t *testing.T) {
+	model := &componentResourceModel{
+		Fields: []listFieldModel{
+			{Name: types.StringValue("title"), fieldModel: fieldModel{Type: types.StringValue("text")}},
+			{Name: types.StringValue("seo"), fieldModel: fieldModel{Type: types.StringValue("text")}},
+			{Name: types.StringValue("intro"), fieldModel: fieldModel{Type: types.StringValue("textarea")}},
+		},
+		Tabs: []tabModel{
+			{Key: types.StringValue("tab-seo"), Fields: []types.String{types.StringValue("seo")}},
+		},
+	}
+
+	fields := model.fieldSchema()
+	assert.Equal(t, int64(1), fields["title"].Position.ValueInt64())
+	assert.True(t, fields["seo"].Position.IsNull())
+	assert.Equal(t, int64(2), fields["intro"].Position.ValueInt64())
+
+	model.setImpliedPositions()
+	assert.Equal(t, int64(2), model.Fields[2].Position.ValueInt64())
+	assert.Equal(t, "fields[2].type", model.fieldPath("intro").AtName("type").String())
+
+	schema := utils.SortComponentFields(model.toFieldInputs())
+	keys := []string{}
+	for pair := schema.Oldest(); pair != nil; pair = pair.Next() {
+		keys = append(keys, pair.Key)
+	}
+	assert.Equal(t, []string{"title", "intro", "tab-seo", "seo"}, keys)
+}
+
+func TestToListFields(t *testing.T) {
+	fields := map[string]fieldModel{
+		"title": {Position: types.Int64Value(2)},
+		"intro": {Position: types.Int64Value(1)},
+		"seo":   {Position: types.Int64Null()},
+		"body":  {Position: types.Int64Value(3)},
+		"image": {Position: types.Int64Value(3)},
+	}
+	current := []listFieldModel{
+		{Name: types.StringValue("title")},
+		{Name: types.StringValue("removed")},
+		{Name: types.StringValue("intro")},
+	}
+
+	names := []string{}
+	for _, field := range toListFields(fields, current) {
+		names = append(names, field.Name.ValueString())
+	}
+	assert.Equal(t, []string{"title", "intro", "body", "image", "seo"}, names)
+}
+
+func TestComponentResourceModel_ToRequestBodyExtraJSON(t *testing.T) {
+	model := &componentResourceModel{
+		Name:      types.StringValue("article"),
+		ExtraJSON: types.StringValue(`{"metadata":{"owner":"marketing"}}`),
+		Schema: map[string]fieldModel{
+			"title": {
+				Type:      types.StringValue("text"),
+				Position:  types.Int64Value(2),
+				Required:  types.BoolValue(false),
+				ExtraJSON: types.StringValue(`{"ai_assist":{"enabled":true}}`),
+			},
+			"intro": {Type: types.StringValue("textarea"), Position: types.Int64Value(1)},
+		},
+	}
+
+	body, err := model.toRequestBody(model.toRemoteInput())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"component": {
+			"name": "article",
+			"metadata": {"owner": "marketing"},
+			"schema": {
+				"intro": {"type": "textarea", "pos": 1},
+				"title": {"type": "text", "pos": 2, "required": false, "ai_assist": {"enabled": true}}
+			}
+		}
+	}`, string(body))
+	assert.Less(t, strings.Index(string(body), `"intro"`), strings.Index(string(body), `"title"`),
+		"the order of the fields should be kept")
+}
+
+func TestModelledKeys(t *testing.T) {
+	fieldKeys := modelledKeys(sbmgmt.FieldInput{}, unmodelledFieldKeys...)
+	assert.Contains(t, fieldKeys, "pos")
+	assert.Contains(t, fieldKeys, "required")
+	assert.NotContains(t, fieldKeys, "required_fields")
+
+	componentKeys := modelledKeys(sbmgmt.ComponentBase{}, "schema")
+	assert.Contains(t, componentKeys, "display_name")
+	assert.NotContains(t, componentKeys, "schema")
+}
+
+func TestComponentResourceModel_ExtraFromRemote(t *testing.T) {
+	model := &componentResourceModel{
+		ExtraJSON: types.StringValue(`{ "metadata": { "owner": "marketing" } }`),
+		Fields: []listFieldModel{
+			{
+				Name:       types.StringValue("title"),
+				fieldModel: fieldModel{ExtraJSON: types.StringValue(`{"ai_assist":{"enabled":true},"unknown":1}`)},
+			},
+			{Name: types.StringValue("intro")},
+		},
+	}
+
+	body := `{
+		"component": {
+			"name": "article",
+			"metadata": {"owner": "marketing", "team": "web"},
+			"schema": {
+				"title": {"type": "text", "ai_assist": {"enabled": false, "model": "default"}},
+				"intro": {"type": "textarea"}
+			}
+		}
+	}`
+	require.NoError(t, model.extraFromRemote([]byte(body)))
+
+	assert.Equal(t, `{ "metadata": { "owner": "marketing" } }`, model.ExtraJSON.ValueString(),
+		"the extra_json should be kept when it is equal to the remote values")
+	assert.Equal(t, `{"ai_assist":{"enabled":false}}`, model.Fields[0].ExtraJSON.ValueString())
+	assert.True(t, model.Fields[1].ExtraJSON.IsNull())
+}
@@ -1,16 +1,23 @@
 package component
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -20,15 +27,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/labd/storyblok-go-sdk/sbmgmt"
 
+	"github.com/labd/terraform-provider-storyblok/internal/content"
 	"github.com/labd/terraform-provider-storyblok/internal/customvalidators"
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
 	"github.com/labd/terraform-provider-storyblok/internal/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &componentResource{}
-	_ resource.ResourceWithConfigure   = &componentResource{}
-	_ resource.ResourceWithImportState = &componentResource{}
+	_ resource.Resource                     = &componentResource{}
+	_ resource.ResourceWithConfigure        = &componentResource{}
+	_ resource.ResourceWithImportState      = &componentResource{}
+	_ resource.ResourceWithModifyPlan       = &componentResource{}
+	_ resource.ResourceWithValidateConfig   = &componentResource{}
+	_ resource.ResourceWithConfigValidators = &componentResource{}
 )
 
 // NewComponentResource is Int64ToStringInterfacePointer helper function to simplify the provider implementation.
@@ -38,7 +50,9 @@ func NewComponentResource() resource.Resource {
 
 // componentResource is the resource implementation.
 type componentResource struct {
-	client sbmgmt.ClientWithResponsesInterface
+	client     sbmgmt.ClientWithResponsesInterface
+	api        *mapi.Client
+	components *utils.ComponentRegistry
 }
 
 // Metadata returns the data source type name.
@@ -128,349 +142,496 @@ func (r *componentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "The display name of the component",
 				Optional:    true,
 			},
-			"schema": schema.MapNestedAttribute{
-				Description: "Schema of this component.",
-				Required:    true,
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Prevent deleting the component while it is used by stories. The stories using " +
+					"the component are listed when the deletion is refused. Defaults to `true`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"tabs": schema.ListNestedAttribute{
+				Description: "The tabs of the component in the editor, in order. The tabs are placed after the " +
+					"fields which are not part of a tab, and the fields and sections of each tab are placed in the " +
+					"listed order, so fields in tabs don't have a position.",
+				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"type": schema.StringAttribute{
-							Description: "The type of the field",
+						"key": schema.StringAttribute{
+							Description: "The key of the tab field, for example `tab-seo`. Must not be a key of the schema.",
 							Required:    true,
 							Validators: []validator.String{
-								stringvalidator.OneOf(pie.Keys(getComponentTypes())...),
+								stringvalidator.LengthAtLeast(1),
 							},
 						},
-						"position": schema.Int64Attribute{
-							Description: "The position of the field",
-							Required:    true,
-						},
-						"add_https": schema.BoolAttribute{
-							Description: "Prepends https: to stop usage of relative protocol",
-							Optional:    true,
-						},
-						"allow_target_blank": schema.BoolAttribute{
-							Description: "Allows to open links in Int64ToStringInterfacePointer new tab for Richtext; Default: false",
-							Optional:    true,
-						},
-						"asset_folder_id": schema.Int64Attribute{
-							Description: "Default asset folder numeric id to store uploaded image of that field",
-							Optional:    true,
-						},
-						"can_sync": schema.BoolAttribute{
-							Description: "Advanced usage to sync with field in preview; Default: false",
-							Optional:    true,
-						},
-						"customize_toolbar": schema.BoolAttribute{
-							Description: "Allow to customize the Markdown or Richtext toolbar; Default: false",
+						"display_name": schema.StringAttribute{
+							Description: "The name of the tab shown in the editor.",
 							Optional:    true,
 						},
-						"component_whitelist": schema.ListAttribute{
-							Description: "Array of component/content type names: [\"post\",\"page\",\"product\"]. Only for type: bloks, multilink, and richtext (for nestable bloks inside that)",
-							Optional:    true,
+						"fields": schema.ListAttribute{
+							Description: "The keys of the fields and `sections` in the tab, in order.",
+							Required:    true,
 							ElementType: types.StringType,
-						},
-						"conditional_settings": schema.ListNestedAttribute{
-							Description: "Array containing the object with information about conditions set on the field",
-							Optional:    true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"modifications": schema.ListNestedAttribute{
-										Required:    true,
-										Description: "List of modifications to be applied to the field. Only 1 modification can be applied at Int64ToStringInterfacePointer time (display OR required)",
-										NestedObject: schema.NestedAttributeObject{
-											Attributes: map[string]schema.Attribute{
-												"display": schema.StringAttribute{
-													Optional:    true,
-													Description: "Hide the target field if the rule conditions are met",
-													Validators:  []validator.String{stringvalidator.OneOf("hide")},
-												},
-												"required": schema.BoolAttribute{
-													Optional:    true,
-													Description: "Make the target field required / optional if the rule conditions are met",
-												},
-											},
-										},
-									},
-									"rule_match": schema.StringAttribute{
-										Description: "Define if all or any of the conditions should be met to apply the modifications",
-										Required:    true,
-										Validators:  []validator.String{stringvalidator.OneOf("any", "all")},
-									},
-									"rule_conditions": schema.ListNestedAttribute{
-										Description: "Conditional rules to be applied to the target field",
-										Required:    true,
-										NestedObject: schema.NestedAttributeObject{
-											Attributes: map[string]schema.Attribute{
-												"validation": schema.StringAttribute{
-													Required:   true,
-													Validators: []validator.String{stringvalidator.OneOf("empty", "not_empty", "equals", "not_equals")},
-												},
-												"value": schema.StringAttribute{
-													Optional: true,
-													Computed: true,
-													Default:  stringdefault.StaticString("empty"),
-												},
-												"validated_object": schema.SingleNestedAttribute{
-													Required: true,
-													Attributes: map[string]schema.Attribute{
-														"field_key": schema.StringAttribute{
-															Required: true,
-														},
-													},
-												},
-											},
-										},
-									},
-								},
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.UniqueValues(),
 							},
 						},
-						"datasource_slug": schema.StringAttribute{
-							Description: "Define selectable datasources string; Effects editor only if source=internal",
-							Optional:    true,
-						},
-						"default_value": schema.StringAttribute{
-							Description: "Default value for the field; Can be an escaped JSON object",
-							Optional:    true,
-						},
-						"description": schema.StringAttribute{
-							Description: "Description shown in the editor interface",
-							Optional:    true,
-						},
-						"disable_time": schema.BoolAttribute{
-							Description: "Disables time selection from date picker; Default: false",
-							Optional:    true,
+					},
+				},
+			},
+			"sections": schema.ListNestedAttribute{
+				Description: "The sections of the component in the editor, in order. Sections which are listed " +
+					"in the fields of a tab are placed in the tab, the other sections are placed after the fields " +
+					"which are not part of a tab or section. The fields of each section are placed in the listed " +
+					"order, so fields in sections don't have a position.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description: "The key of the section field, for example `section-meta`. Must not be a key " +
+								"of the schema.",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
 						},
 						"display_name": schema.StringAttribute{
-							Description: "Display name shown in the editor interface",
+							Description: "The name of the section shown in the editor.",
 							Optional:    true,
 						},
-						"external_datasource": schema.StringAttribute{
-							Description: "Define external datasource JSON Url; Effects editor only if source=external",
-							Optional:    true,
-						},
-						"field_type": schema.StringAttribute{
-							Description: "Name of the custom field type plugin",
-							Optional:    true,
-						},
-						"filetypes": schema.ListAttribute{
-							Description: "Array of file type names: [\"images\", \"videos\", \"audios\", \"texts\"]",
-							Optional:    true,
-							ElementType: types.StringType,
-						},
-						"filter_content_type": schema.ListAttribute{
-							Description: "An array of content types that can be selected in Int64ToStringInterfacePointer option or options field where source is internal_stories: [\"post\", \"faq_item\"]",
-							Optional:    true,
-							ElementType: types.StringType,
-						},
-						"force_link_scope": schema.BoolAttribute{
-							Description: "Force link scope to be internal_stories; Default: false",
-							Optional:    true,
-						},
-						"folder_slug": schema.StringAttribute{
-							Description: "Filter on selectable stories path; Effects editor only if source=internal_stories; In case you have Int64ToStringInterfacePointer multi-language folder structure you can add the '{0}' placeholder and the path will be adapted dynamically. Examples: \"{0}/categories/\", {0}/{1}/categories/",
-							Optional:    true,
-						},
-						"image_crop": schema.BoolAttribute{
-							Description: "Activate force crop for images: (true/false)",
-							Optional:    true,
-						},
-						"image_height": schema.StringAttribute{
-							Description: "Define height in px or height ratio if keep_image_size is enabled",
-							Optional:    true,
-						},
-						"image_width": schema.StringAttribute{
-							Description: "Define width in px or width ratio if keep_image_size is enabled",
-							Optional:    true,
-						},
-						"keep_image_size": schema.BoolAttribute{
-							Description: "Keep original size: (true/false)",
-							Optional:    true,
-						},
-						"keys": schema.ListAttribute{
-							Description: "Array of field keys to include in this section",
-							Optional:    true,
+						"fields": schema.ListAttribute{
+							Description: "The keys of the fields in the section, in order.",
+							Required:    true,
 							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.UniqueValues(),
+							},
 						},
-						"link_scope": schema.StringAttribute{
-							Description: "A path to Int64ToStringInterfacePointer folder to restrict the link scope",
-							Optional:    true,
-						},
-						"max_length": schema.Int64Attribute{
-							Description: "Set the max length of the input string",
-							Optional:    true,
-						},
-						"maximum": schema.Int64Attribute{
-							Description: "Maximum amount of added bloks in this blok field",
-							Optional:    true,
-						},
-						"minimum": schema.Int64Attribute{
-							Description: "Minimum amount of added bloks in this blok field",
-							Optional:    true,
-						},
-						"max_options": schema.Int64Attribute{
-							Description: "Maximum amount of options for this options field",
-							Optional:    true,
-						},
-						"min_options": schema.Int64Attribute{
-							Description: "Minimum amount of options for this options field",
-							Optional:    true,
-						},
-						"no_translate": schema.BoolAttribute{
-							Description: "Should be excluded in translation export",
-							Optional:    true,
+					},
+				},
+			},
+			"publish_migrated_stories": schema.BoolAttribute{
+				Description: "Publish the stories whose content is moved by `renamed_from` again, when they " +
+					"were published without unpublished changes. Otherwise the migrated content is saved as a " +
+					"draft. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"protect_fields": schema.BoolAttribute{
+				Description: "Fail the plan when a field is removed or the type of a field changes, as the " +
+					"content of the field is lost in the editor. Without it a warning is shown. Use " +
+					"`renamed_from` on the field to rename a field without losing content.",
+				Optional: true,
+			},
+			"extra_json": schema.StringAttribute{
+				Description: "JSON encoded object with additional properties of the component, for settings " +
+					"that are not supported by this provider yet. It is deep-merged into the component " +
+					"that is sent to Storyblok. Only the keys that are set are read back. Keys which are set with " +
+					"the attributes of the resource can't be set.",
+				Optional: true,
+				Validators: []validator.String{
+					customvalidators.JSONObject(),
+				},
+			},
+			"schema": schema.MapNestedAttribute{
+				Description: "Schema of this component, with the fields by key. Either `schema` or `fields` must be set.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: fieldAttributes(),
+				},
+			},
+			"fields": schema.ListNestedAttribute{
+				Description: "Fields of this component in order, as an alternative to `schema`. The position of each " +
+					"field follows from the order of the fields.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listFieldAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// fieldAttributes returns the attributes of a field of the component.
+func fieldAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			Description: "The type of the field",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(pie.Keys(getComponentTypes())...),
+			},
+		},
+		"position": schema.Int64Attribute{
+			Description: "The position of the field, which must be unique. Required, except for fields " +
+				"in `tabs` or `sections`, whose position follows from the order of the tabs and sections.",
+			Optional: true,
+		},
+		"add_https": schema.BoolAttribute{
+			Description: "Prepends https: to stop usage of relative protocol",
+			Optional:    true,
+		},
+		"allow_target_blank": schema.BoolAttribute{
+			Description: "Allows to open links in Int64ToStringInterfacePointer new tab for Richtext; Default: false",
+			Optional:    true,
+		},
+		"asset_folder_id": schema.Int64Attribute{
+			Description: "Default asset folder numeric id to store uploaded image of that field",
+			Optional:    true,
+		},
+		"can_sync": schema.BoolAttribute{
+			Description: "Advanced usage to sync with field in preview; Default: false",
+			Optional:    true,
+		},
+		"customize_toolbar": schema.BoolAttribute{
+			Description: "Allow to customize the Markdown or Richtext toolbar; Default: false",
+			Optional:    true,
+		},
+		"component_whitelist": schema.ListAttribute{
+			Description: "Array of component/content type names: [\"post\",\"page\",\"product\"]. Only for type: bloks, multilink, and richtext (for nestable bloks inside that). " +
+				"A warning is shown for components that don't exist in the space when planning, which includes " +
+				"components created in the same run.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"conditional_settings": schema.ListNestedAttribute{
+			Description: "Array containing the object with information about conditions set on the field",
+			Optional:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"modifications": schema.ListNestedAttribute{
+						Required:    true,
+						Description: "List of modifications to be applied to the field. Only 1 modification can be applied at Int64ToStringInterfacePointer time (display OR required)",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"display": schema.StringAttribute{
+									Optional:    true,
+									Description: "Hide the target field if the rule conditions are met",
+									Validators:  []validator.String{stringvalidator.OneOf("hide")},
+								},
+								"required": schema.BoolAttribute{
+									Optional:    true,
+									Description: "Make the target field required / optional if the rule conditions are met",
+								},
+							},
 						},
-						"options": schema.ListNestedAttribute{
-							Description: "Array of datasource entries [{name:\"\", value:\"\"}]; Effects editor only if source=undefined",
-							Optional:    true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"name": schema.StringAttribute{
-										Description: "Name of the datasource entry",
-										Required:    true,
-									},
-									"value": schema.StringAttribute{
-										Description: "Value of the datasource entry",
-										Required:    true,
+					},
+					"rule_match": schema.StringAttribute{
+						Description: "Define if all or any of the conditions should be met to apply the modifications",
+						Required:    true,
+						Validators:  []validator.String{stringvalidator.OneOf("any", "all")},
+					},
+					"rule_conditions": schema.ListNestedAttribute{
+						Description: "Conditional rules to be applied to the target field",
+						Required:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"validation": schema.StringAttribute{
+									Required:   true,
+									Validators: []validator.String{stringvalidator.OneOf("empty", "not_empty", "equals", "not_equals")},
+								},
+								"value": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  stringdefault.StaticString("empty"),
+								},
+								"validated_object": schema.SingleNestedAttribute{
+									Required: true,
+									Attributes: map[string]schema.Attribute{
+										"field_key": schema.StringAttribute{
+											Required: true,
+										},
 									},
 								},
 							},
 						},
-						"regex": schema.StringAttribute{
-							Description: "Client Regex validation for the field",
-							Optional:    true,
-						},
-						"required": schema.BoolAttribute{
-							Description: "Is field required; Default: false",
-							Optional:    true,
-						},
-						"restrict_components": schema.BoolAttribute{
-							Description: "Activate restriction nestable component option; Default: false",
-							Optional:    true,
-						},
-						"restrict_content_types": schema.BoolAttribute{
-							Description: "Activate restriction content type option",
-							Optional:    true,
-						},
-						"rich_markdown": schema.BoolAttribute{
-							Description: "Enable rich markdown view by default (true/false)",
-							Optional:    true,
-						},
-						"rtl": schema.BoolAttribute{
-							Description: "Enable global RTL for this field",
-							Optional:    true,
-						},
-						"source": schema.StringAttribute{
-							Description: "Possible values: undefined: Self; internal_stories: Stories; internal: Datasource; external: API Endpoint in Datasource Entries Array Format",
-							Optional:    true,
-						},
-						"tooltip": schema.BoolAttribute{
-							Description: "Show the description as Int64ToStringInterfacePointer tooltip",
-							Optional:    true,
-						},
-						"translatable": schema.BoolAttribute{
-							Description: "Can field be translated; Default: false",
-							Optional:    true,
-						},
-						"toolbar": schema.ListAttribute{
-							Description: "Array of toolbar keys to include in the Richtext or Markdown toolbar",
-							Optional:    true,
-							ElementType: types.StringType,
-						},
-						"use_uuid": schema.BoolAttribute{
-							Description: "Default: true; available in option and source=internal_stories",
-							Optional:    true,
-						},
-						"steps": schema.Int64Attribute{
-							Description: "Step interval for number fields",
-							Optional:    true,
-						},
-						"show_anchor": schema.BoolAttribute{
-							Description: "Enables anchor field for internal links in multilink fields",
-							Optional:    true,
-						},
-						"restrict_type": schema.StringAttribute{
-							Description: "Restricts the type of components used in bloks fields (e.g., tags, groups).",
-							Optional:    true,
-						},
-						"component_group_whitelist": schema.ListAttribute{
-							Description: "Array of group UUIDs for restricting components in bloks fields",
-							Optional:    true,
-							ElementType: types.StringType,
-						},
-						"component_tag_whitelist": schema.ListAttribute{
-							Description: "Array of tag IDs for restricting components in bloks fields",
-							Optional:    true,
-							ElementType: types.Int64Type,
-						},
-						"asset_link_type": schema.BoolAttribute{
-							Description: "Allows assets in multilink fields",
-							Optional:    true,
-						},
-						"allow_advanced_search": schema.BoolAttribute{
-							Description: "Allows advanced search in option fields",
-							Optional:    true,
-						},
-						"allow_custom_attributes": schema.BoolAttribute{
-							Description: "Enables custom attributes in links for richtext or multilink fields",
-							Optional:    true,
-						},
-						"allow_external_url": schema.BoolAttribute{
-							Description: "Allows external URLs in asset or multiasset fields",
-							Optional:    true,
-						},
-						"allow_multiline": schema.BoolAttribute{
-							Description: "Enables empty paragraphs in markdown fields",
-							Optional:    true,
-						},
-						"decimals": schema.Int64Attribute{
-							Description: "Number of decimal places for number fields",
-							Optional:    true,
-						},
-						"email_link_type": schema.BoolAttribute{
-							Description: "Allows email links in multilink fields",
-							Optional:    true,
-						},
-						"entry_appearance": schema.StringAttribute{
-							Description: "Appearance of an option (link or card) in an option field",
-							Optional:    true,
-						},
-						"exclude_empty_option": schema.BoolAttribute{
-							Description: "Hides empty options in option fields",
-							Optional:    true,
-						},
-						"exclude_from_merge": schema.BoolAttribute{
-							Description: "Excludes Int64ToStringInterfacePointer blok from merge actions (Dimensions App).",
-							Optional:    true,
-						},
-						"exclude_from_overwrite": schema.BoolAttribute{
-							Description: "Excludes Int64ToStringInterfacePointer blok from overwrite actions (Dimensions App).",
-							Optional:    true,
-						},
-						"force_merge": schema.BoolAttribute{
-							Description: "Forces overwriting Int64ToStringInterfacePointer blok during Int64ToStringInterfacePointer merge action (Dimensions App).",
-							Optional:    true,
-						},
-						"inline_label": schema.BoolAttribute{
-							Description: "Makes the label of Int64ToStringInterfacePointer boolean field inline",
-							Optional:    true,
-						},
-						"is_reference_type": schema.BoolAttribute{
-							Description: "True if the options field is of type reference",
-							Optional:    true,
-						},
-						"max_value": schema.Int64Attribute{
-							Description: "Maximum value for number fields",
-							Optional:    true,
-						},
-						"min_value": schema.Int64Attribute{
-							Description: "Minimum value for number fields",
-							Optional:    true,
-						},
 					},
 				},
 			},
 		},
+		"datasource_slug": schema.StringAttribute{
+			Description: "Define selectable datasources string; Effects editor only if source=internal",
+			Optional:    true,
+		},
+		"default_value": schema.StringAttribute{
+			Description: "Default value for the field; Can be an escaped JSON object",
+			Optional:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Description shown in the editor interface",
+			Optional:    true,
+		},
+		"disable_time": schema.BoolAttribute{
+			Description: "Disables time selection from date picker; Default: false",
+			Optional:    true,
+		},
+		"display_name": schema.StringAttribute{
+			Description: "Display name shown in the editor interface",
+			Optional:    true,
+		},
+		"external_datasource": schema.StringAttribute{
+			Description: "Define external datasource JSON Url; Effects editor only if source=external",
+			Optional:    true,
+		},
+		"field_type": schema.StringAttribute{
+			Description: "Name of the custom field type plugin",
+			Optional:    true,
+		},
+		"filetypes": schema.ListAttribute{
+			Description: "Array of file type names: [\"images\", \"videos\", \"audios\", \"texts\"]",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"filter_content_type": schema.ListAttribute{
+			Description: "An array of content types that can be selected in Int64ToStringInterfacePointer option or options field where source is internal_stories: [\"post\", \"faq_item\"]. " +
+				"A warning is shown for content types that are not root components, or that don't exist in the space " +
+				"when planning, which includes components created in the same run.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"force_link_scope": schema.BoolAttribute{
+			Description: "Force link scope to be internal_stories; Default: false",
+			Optional:    true,
+		},
+		"folder_slug": schema.StringAttribute{
+			Description: "Filter on selectable stories path; Effects editor only if source=internal_stories; In case you have Int64ToStringInterfacePointer multi-language folder structure you can add the '{0}' placeholder and the path will be adapted dynamically. Examples: \"{0}/categories/\", {0}/{1}/categories/",
+			Optional:    true,
+		},
+		"image_crop": schema.BoolAttribute{
+			Description: "Activate force crop for images: (true/false)",
+			Optional:    true,
+		},
+		"image_height": schema.StringAttribute{
+			Description: "Define height in px or height ratio if keep_image_size is enabled",
+			Optional:    true,
+		},
+		"image_width": schema.StringAttribute{
+			Description: "Define width in px or width ratio if keep_image_size is enabled",
+			Optional:    true,
+		},
+		"keep_image_size": schema.BoolAttribute{
+			Description: "Keep original size: (true/false)",
+			Optional:    true,
+		},
+		"keys": schema.ListAttribute{
+			Description: "Array of field keys to include in this section",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"link_scope": schema.StringAttribute{
+			Description: "A path to Int64ToStringInterfacePointer folder to restrict the link scope",
+			Optional:    true,
+		},
+		"max_length": schema.Int64Attribute{
+			Description: "Set the max length of the input string",
+			Optional:    true,
+		},
+		"maximum": schema.Int64Attribute{
+			Description: "Maximum amount of added bloks in this blok field",
+			Optional:    true,
+		},
+		"minimum": schema.Int64Attribute{
+			Description: "Minimum amount of added bloks in this blok field",
+			Optional:    true,
+		},
+		"max_options": schema.Int64Attribute{
+			Description: "Maximum amount of options for this options field",
+			Optional:    true,
+		},
+		"min_options": schema.Int64Attribute{
+			Description: "Minimum amount of options for this options field",
+			Optional:    true,
+		},
+		"no_translate": schema.BoolAttribute{
+			Description: "Should be excluded in translation export",
+			Optional:    true,
+		},
+		"options": schema.ListNestedAttribute{
+			Description: "Array of datasource entries [{name:\"\", value:\"\"}]; Effects editor only if source=undefined",
+			Optional:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Name of the datasource entry",
+						Required:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "Value of the datasource entry",
+						Required:    true,
+					},
+				},
+			},
+		},
+		"regex": schema.StringAttribute{
+			Description: "Client Regex validation for the field",
+			Optional:    true,
+		},
+		"required": schema.BoolAttribute{
+			Description: "Is field required; Default: false",
+			Optional:    true,
+		},
+		"restrict_components": schema.BoolAttribute{
+			Description: "Activate restriction nestable component option; Default: false",
+			Optional:    true,
+		},
+		"restrict_content_types": schema.BoolAttribute{
+			Description: "Activate restriction content type option",
+			Optional:    true,
+		},
+		"rich_markdown": schema.BoolAttribute{
+			Description: "Enable rich markdown view by default (true/false)",
+			Optional:    true,
+		},
+		"rtl": schema.BoolAttribute{
+			Description: "Enable global RTL for this field",
+			Optional:    true,
+		},
+		"source": schema.StringAttribute{
+			Description: "Possible values: undefined: Self; internal_stories: Stories; internal: Datasource; external: API Endpoint in Datasource Entries Array Format",
+			Optional:    true,
+		},
+		"tooltip": schema.BoolAttribute{
+			Description: "Show the description as Int64ToStringInterfacePointer tooltip",
+			Optional:    true,
+		},
+		"translatable": schema.BoolAttribute{
+			Description: "Can field be translated; Default: false",
+			Optional:    true,
+		},
+		"toolbar": schema.ListAttribute{
+			Description: "Array of toolbar keys to include in the Richtext or Markdown toolbar",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"use_uuid": schema.BoolAttribute{
+			Description: "Default: true; available in option and source=internal_stories",
+			Optional:    true,
+		},
+		"steps": schema.Int64Attribute{
+			Description: "Step interval for number fields",
+			Optional:    true,
+		},
+		"show_anchor": schema.BoolAttribute{
+			Description: "Enables anchor field for internal links in multilink fields",
+			Optional:    true,
+		},
+		"restrict_type": schema.StringAttribute{
+			Description: "Restricts the type of components used in bloks fields (e.g., tags, groups).",
+			Optional:    true,
+		},
+		"component_group_whitelist": schema.ListAttribute{
+			Description: "Array of group UUIDs for restricting components in bloks fields",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"component_tag_whitelist": schema.ListAttribute{
+			Description: "Array of tag IDs for restricting components in bloks fields",
+			Optional:    true,
+			ElementType: types.Int64Type,
+		},
+		"asset_link_type": schema.BoolAttribute{
+			Description: "Allows assets in multilink fields",
+			Optional:    true,
+		},
+		"allow_advanced_search": schema.BoolAttribute{
+			Description: "Allows advanced search in option fields",
+			Optional:    true,
+		},
+		"allow_custom_attributes": schema.BoolAttribute{
+			Description: "Enables custom attributes in links for richtext or multilink fields",
+			Optional:    true,
+		},
+		"allow_external_url": schema.BoolAttribute{
+			Description: "Allows external URLs in asset or multiasset fields",
+			Optional:    true,
+		},
+		"allow_multiline": schema.BoolAttribute{
+			Description: "Enables empty paragraphs in markdown fields",
+			Optional:    true,
+		},
+		"decimals": schema.Int64Attribute{
+			Description: "Number of decimal places for number fields",
+			Optional:    true,
+		},
+		"email_link_type": schema.BoolAttribute{
+			Description: "Allows email links in multilink fields",
+			Optional:    true,
+		},
+		"entry_appearance": schema.StringAttribute{
+			Description: "Appearance of an option (link or card) in an option field",
+			Optional:    true,
+		},
+		"exclude_empty_option": schema.BoolAttribute{
+			Description: "Hides empty options in option fields",
+			Optional:    true,
+		},
+		"exclude_from_merge": schema.BoolAttribute{
+			Description: "Excludes Int64ToStringInterfacePointer blok from merge actions (Dimensions App).",
+			Optional:    true,
+		},
+		"exclude_from_overwrite": schema.BoolAttribute{
+			Description: "Excludes Int64ToStringInterfacePointer blok from overwrite actions (Dimensions App).",
+			Optional:    true,
+		},
+		"force_merge": schema.BoolAttribute{
+			Description: "Forces overwriting Int64ToStringInterfacePointer blok during Int64ToStringInterfacePointer merge action (Dimensions App).",
+			Optional:    true,
+		},
+		"inline_label": schema.BoolAttribute{
+			Description: "Makes the label of Int64ToStringInterfacePointer boolean field inline",
+			Optional:    true,
+		},
+		"is_reference_type": schema.BoolAttribute{
+			Description: "True if the options field is of type reference",
+			Optional:    true,
+		},
+		"max_value": schema.Int64Attribute{
+			Description: "Maximum value for number fields",
+			Optional:    true,
+		},
+		"min_value": schema.Int64Attribute{
+			Description: "Minimum value for number fields",
+			Optional:    true,
+		},
+		"extra_json": schema.StringAttribute{
+			Description: "JSON encoded object with additional properties of the field, for settings " +
+				"that are not supported by this provider yet. It is deep-merged into the field " +
+				"that is sent to Storyblok. Only the keys that are set are read back. Keys which are set with " +
+				"the attributes of the field can't be set.",
+			Optional: true,
+			Validators: []validator.String{
+				customvalidators.JSONObject(),
+			},
+		},
+		"renamed_from": schema.StringAttribute{
+			Description: "The previous key of the field. When the field is renamed the content of " +
+				"the old key is moved to the new key in all stories using the component, instead " +
+				"of being lost. The stories are saved as drafts, unless `publish_migrated_stories` " +
+				"is set on the component.",
+			Optional: true,
+		},
+	}
+}
+
+// listFieldAttributes returns the attributes of a field in the ordered list of
+// fields, which has a name and a computed position.
+func listFieldAttributes() map[string]schema.Attribute {
+	attributes := fieldAttributes()
+	attributes["name"] = schema.StringAttribute{
+		Description: "The key of the field",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["position"] = schema.Int64Attribute{
+		Description: "The position of the field, which follows from the order of the fields. Fields in `tabs` " +
+			"or `sections` don't have a position.",
+		Computed: true,
 	}
+	return attributes
 }
 
 // Configure adds the provider configured client to the data source.
@@ -480,6 +641,161 @@ func (r *componentResource) Configure(_ context.Context, req resource.ConfigureR
 	}
 
 	r.client = utils.GetClient(req.ProviderData)
+	r.api = utils.GetAPIClient(req.ProviderData)
+	r.components = utils.GetComponentRegistry(req.ProviderData)
+}
+
+// ConfigValidators returns the validators of the fields in the schema.
+func (r *componentResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		fieldAttributesValidator{},
+		conditionalSettingsValidator{},
+		positionsValidator{},
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("schema"),
+			path.MatchRoot("fields"),
+		),
+	}
+}
+
+// ValidateConfig checks that renamed fields don't refer to fields that still
+// exist.
+func (r *componentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if !fieldsKnown(req.Config.Raw) {
+		return
+	}
+
+	var config componentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The fields are set with the extra_json of the fields instead
+	extra := extraJSONKeys(config.ExtraJSON)
+	if pie.Contains(extra, "schema") {
+		resp.Diagnostics.AddAttributeError(path.Root("extra_json"), "Invalid extra_json",
+			"The schema can't be set in the extra_json of the component, use the extra_json of the fields instead.")
+	}
+	resp.Diagnostics.Append(checkModelledKeys(path.Root("extra_json"), extra,
+		modelledKeys(sbmgmt.ComponentBase{}, "schema"))...)
+
+	fields := config.fieldSchema()
+	fieldKeys := modelledKeys(sbmgmt.FieldInput{}, unmodelledFieldKeys...)
+	for _, name := range utils.SortedKeys(fields) {
+		resp.Diagnostics.Append(checkModelledKeys(config.fieldPath(name).AtName("extra_json"),
+			extraJSONKeys(fields[name].ExtraJSON), fieldKeys)...)
+	}
+
+	renamedFrom := map[string]string{}
+	for _, name := range utils.SortedKeys(fields) {
+		from := fields[name].RenamedFrom
+		if from.IsNull() || from.IsUnknown() {
+			continue
+		}
+
+		attrPath := config.fieldPath(name).AtName("renamed_from")
+		if _, ok := fields[from.ValueString()]; ok {
+			resp.Diagnostics.AddAttributeError(attrPath, "Invalid renamed_from",
+				fmt.Sprintf("Field %s is renamed from %s, but %s is still part of the schema.",
+					name, from.ValueString(), from.ValueString()))
+		}
+		if other, ok := renamedFrom[from.ValueString()]; ok {
+			resp.Diagnostics.AddAttributeError(attrPath, "Invalid renamed_from",
+				fmt.Sprintf("Fields %s and %s are both renamed from %s.", other, name, from.ValueString()))
+		}
+		renamedFrom[from.ValueString()] = name
+	}
+}
+
+// extraJSONKeys returns the keys of the extra_json, in sorted order.
+func extraJSONKeys(extra types.String) []string {
+	if extra.IsNull() || extra.IsUnknown() {
+		return nil
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(extra.ValueString()), &object); err != nil {
+		return nil
+	}
+	return utils.SortedKeys(object)
+}
+
+// checkModelledKeys returns an error for each key of the extra_json which is
+// set from an attribute.
+func checkModelledKeys(attrPath path.Path, keys []string, modelled []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, key := range keys {
+		if pie.Contains(modelled, key) {
+			diags.AddAttributeError(attrPath, "Invalid extra_json",
+				fmt.Sprintf("The key %s can't be set in the extra_json, it is set with the attributes of the "+
+					"resource.", key))
+		}
+	}
+	return diags
+}
+
+// ModifyPlan warns about field changes which lose the content of stories, or
+// fails when protect_fields is set, and checks the references to other
+// components.
+func (r *componentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !fieldsKnown(req.Plan.Raw) {
+		return
+	}
+
+	var plan componentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Fields != nil {
+		plan.setImpliedPositions()
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fields"), plan.Fields)...)
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state componentResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(checkFieldChanges(&plan, &state)...)
+	}
+
+	resp.Diagnostics.Append(r.checkReferences(ctx, &plan)...)
+}
+
+// checkFieldChanges reports the field changes which lose the content of
+// stories.
+func checkFieldChanges(plan *componentResourceModel, state *componentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	report := diags.AddAttributeWarning
+	if plan.ProtectFields.ValueBool() {
+		report = diags.AddAttributeError
+	}
+
+	name := plan.Name.ValueString()
+	changes := plan.fieldChanges(state.fieldSchema())
+	for _, field := range changes.Removed {
+		report(plan.fieldsPath(), "Field removed from component",
+			fmt.Sprintf("Field %s is removed from component %s, its content is no longer available in "+
+				"the editor. Set renamed_from on the new field when the field is renamed.", field, name))
+	}
+	for _, field := range utils.SortedKeys(changes.TypeChanges) {
+		types := changes.TypeChanges[field]
+		report(plan.fieldPath(field).AtName("type"), "Field type changed",
+			fmt.Sprintf("The type of field %s of component %s changes from %s to %s, existing content "+
+				"may not be compatible with the new type.", field, name, types[0], types[1]))
+	}
+	for _, field := range utils.SortedKeys(changes.RenamedTypes) {
+		types := changes.RenamedTypes[field]
+		report(plan.fieldPath(field).AtName("type"), "Field type changed",
+			fmt.Sprintf("Field %s of component %s is renamed and its type changes from %s to %s, the "+
+				"migrated content may not be compatible with the new type.", field, name, types[0], types[1]))
+	}
+	return diags
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -493,10 +809,18 @@ func (r *componentResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// Generate API request body from plan
-	input := plan.toRemoteInput()
+	body, err := plan.toRequestBody(plan.toRemoteInput())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating component",
+			"Could not create component, unexpected error: "+err.Error(),
+		)
+		return
+	}
 	spaceID := plan.SpaceID.ValueInt64()
 
-	content, err := r.client.CreateComponentWithResponse(ctx, spaceID, input)
+	content, err := r.client.CreateComponentWithBodyWithResponse(
+		ctx, spaceID, "application/json", bytes.NewReader(body))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating component",
@@ -518,7 +842,11 @@ func (r *componentResource) Create(ctx context.Context, req resource.CreateReque
 	tflog.Debug(ctx, spew.Sdump(component))
 
 	// Map response body to schema and populate Computed attribute values
-	if err := plan.fromRemote(spaceID, component); err != nil {
+	err = plan.fromRemote(spaceID, component)
+	if err == nil {
+		err = plan.extraFromRemote(content.Body)
+	}
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating component",
 			"Could not create component, unexpected error: "+err.Error(),
@@ -556,7 +884,11 @@ func (r *componentResource) Read(ctx context.Context, req resource.ReadRequest,
 	component := content.JSON200.Component
 
 	// Overwrite items with refreshed state
-	if err := state.fromRemote(spaceId, component); err != nil {
+	err = state.fromRemote(spaceId, component)
+	if err == nil {
+		err = state.extraFromRemote(content.Body)
+	}
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Storyblok Component",
 			"Could not read Storyblok component ID "+state.ID.ValueString()+": "+err.Error(),
@@ -575,18 +907,27 @@ func (r *componentResource) Read(ctx context.Context, req resource.ReadRequest,
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *componentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var plan componentResourceModel
+	var plan, state componentResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Generate API request body from plan
-	input := plan.toUpdateInput()
+	body, err := plan.toRequestBody(plan.toUpdateInput())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating component",
+			"Could not update component, unexpected error: "+err.Error(),
+		)
+		return
+	}
 	spaceID := plan.SpaceID.ValueInt64()
 
-	content, err := r.client.UpdateComponentWithResponse(ctx, spaceID, plan.ComponentID.ValueInt64(), input)
+	content, err := r.client.UpdateComponentWithBodyWithResponse(
+		ctx, spaceID, plan.ComponentID.ValueInt64(), "application/json", bytes.NewReader(body))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating component",
@@ -608,7 +949,11 @@ func (r *componentResource) Update(ctx context.Context, req resource.UpdateReque
 	tflog.Debug(ctx, spew.Sdump(component))
 
 	// Map response body to schema and populate Computed attribute values
-	if err := plan.fromRemote(spaceID, component); err != nil {
+	err = plan.fromRemote(spaceID, component)
+	if err == nil {
+		err = plan.extraFromRemote(content.Body)
+	}
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating component",
 			"Could not create component, unexpected error: "+err.Error(),
@@ -616,6 +961,13 @@ func (r *componentResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	// Move the content of renamed fields to their new key before the state is
+	// saved, so the migration is retried with the next apply when it fails
+	resp.Diagnostics.Append(r.migrateRenamedFields(ctx, spaceID, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -623,6 +975,94 @@ func (r *componentResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 }
 
+// migrateRenamedFields moves the content of renamed fields in all stories
+// using the component. Both the old and the new name of the component are
+// migrated when the component itself is renamed as well.
+func (r *componentResource) migrateRenamedFields(ctx context.Context, spaceID int64, plan *componentResourceModel, state *componentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	names := []string{plan.Name.ValueString()}
+	if !state.Name.Equal(plan.Name) {
+		names = append(names, state.Name.ValueString())
+	}
+
+	renames := plan.fieldRenames(state.fieldSchema())
+	for _, from := range utils.SortedKeys(renames) {
+		to := renames[from]
+		for _, name := range names {
+			stories, d := content.MigrateStories(ctx, r.api, spaceID, name, content.RenameField(from, to), false,
+				plan.PublishMigratedStories.ValueBool())
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+			tflog.Info(ctx, fmt.Sprintf("moved field %s to %s of component %s in %d stories", from, to, name, len(stories)))
+		}
+	}
+	return diags
+}
+
+// checkReferences warns about whitelisted components and content types which
+// don't exist in the space, and about content types which are not root
+// components. Only the remote components and the component itself are
+// checked, as other components planned in the same run are not known in a
+// deterministic way.
+func (r *componentResource) checkReferences(ctx context.Context, plan *componentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.components == nil || plan.SpaceID.IsUnknown() || !plan.hasComponentReferences() {
+		return diags
+	}
+	spaceID := plan.SpaceID.ValueInt64()
+	lookup := func(name string) (bool, bool) {
+		if !plan.Name.IsUnknown() && name == plan.Name.ValueString() {
+			return true, plan.IsRoot.ValueBool()
+		}
+		return r.components.Lookup(spaceID, name)
+	}
+
+	err := r.components.LoadRemote(ctx, r.client, spaceID)
+	if err != nil {
+		diags.AddWarning("Unable to validate component references", err.Error())
+		return diags
+	}
+
+	fields := plan.fieldSchema()
+	for _, name := range utils.SortedKeys(fields) {
+		field := fields[name]
+		for _, component := range field.ComponentWhitelist {
+			if component.IsUnknown() || component.IsNull() {
+				continue
+			}
+			if exists, _ := lookup(component.ValueString()); !exists {
+				diags.AddAttributeWarning(plan.fieldPath(name).AtName("component_whitelist"),
+					"Unknown component",
+					fmt.Sprintf("Field %s allows component %s, which doesn't exist in the space. The warning "+
+						"can be ignored when the component is created in the same run.",
+						name, component.ValueString()))
+			}
+		}
+		for _, component := range field.FilterContentType {
+			if component.IsUnknown() || component.IsNull() {
+				continue
+			}
+			attrPath := plan.fieldPath(name).AtName("filter_content_type")
+			exists, isRoot := lookup(component.ValueString())
+			switch {
+			case !exists:
+				diags.AddAttributeWarning(attrPath, "Unknown content type",
+					fmt.Sprintf("Field %s filters on content type %s, which doesn't exist in the space. The "+
+						"warning can be ignored when the component is created in the same run.",
+						name, component.ValueString()))
+			case !isRoot:
+				diags.AddAttributeWarning(attrPath, "Invalid content type",
+					fmt.Sprintf("Field %s filters on content type %s, which is not a root component.",
+						name, component.ValueString()))
+			}
+		}
+	}
+	return diags
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *componentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
@@ -634,6 +1074,14 @@ func (r *componentResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 
 	spaceId, componentId := utils.ParseIdentifier(state.ID.ValueString())
+
+	if !state.DeletionProtection.Equal(types.BoolValue(false)) {
+		if d := checkUnused(ctx, r.api, spaceId, state.Name.ValueString()); d != nil {
+			resp.Diagnostics.Append(d)
+			return
+		}
+	}
+
 	content, err := r.client.DeleteComponentWithResponse(ctx, spaceId, componentId)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -657,3 +1105,42 @@ func (r *componentResource) ImportState(ctx context.Context, req resource.Import
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// checkUnused returns an error when stories still use the component.
+func checkUnused(ctx context.Context, api *mapi.Client, spaceID int64, name string) diag.Diagnostic {
+	const limit = 25
+
+	content, err := api.ListStories(ctx, spaceID, mapi.ListStoriesParams{
+		ContainComponent: name,
+		PerPage:          limit,
+	})
+	if d := utils.CheckGetError("stories of space", spaceID, content, err); d != nil {
+		return d
+	}
+
+	stories := content.JSON.Stories
+	if len(stories) == 0 {
+		return nil
+	}
+
+	total := content.Total()
+	if total < len(stories) {
+		total = len(stories)
+	}
+
+	slugs := make([]string, len(stories))
+	for i, story := range stories {
+		slugs[i] = story.FullSlug
+	}
+	usage := strings.Join(slugs, ", ")
+	if total > len(stories) {
+		usage += fmt.Sprintf(" and %d more", total-len(stories))
+	}
+
+	return diag.NewErrorDiagnostic(
+		"Component is used by stories",
+		fmt.Sprintf("Could not delete component %s, it is used by %d stories: %s. "+
+			"Set deletion_protection to false and apply before deleting the component to remove it anyway.",
+			name, total, usage),
+	)
+}
@@ -0,0 +1,219 @@
+package component
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// readOnlyComponentKeys are the properties of the components in the JSON of
+// the Storyblok CLI which are set by Storyblok, and are ignored.
+var readOnlyComponentKeys = []string{
+	"id", "created_at", "updated_at", "real_name", "all_presets", "preset_id", "internal_tags_list",
+	"component_group_name",
+}
+
+// componentSetResourceModel maps the resource schema data.
+type componentSetResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	SpaceID            types.Int64  `tfsdk:"space_id"`
+	JSON               types.String `tfsdk:"json"`
+	Components         types.Map    `tfsdk:"components"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+}
+
+// componentDefinition is a component in the JSON of the Storyblok CLI. The
+// properties are kept as raw JSON, so the order of the fields in the schema is
+// kept when the component is sent to Storyblok.
+type componentDefinition struct {
+	Name       string
+	Properties map[string]json.RawMessage
+}
+
+// parseComponentsJSON returns the components in the JSON of the Storyblok
+// CLI, which is either an object with a list of components, as written by
+// pull-components, or the list of components itself.
+func parseComponentsJSON(value string) ([]componentDefinition, error) {
+	raw := []byte(value)
+	var document struct {
+		Components []map[string]json.RawMessage `json:"components"`
+	}
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(raw, &document.Components); err != nil {
+			return nil, err
+		}
+	} else if err := json.Unmarshal(raw, &document); err != nil {
+		return nil, err
+	}
+
+	result := make([]componentDefinition, 0, len(document.Components))
+	names := map[string]bool{}
+	for i, properties := range document.Components {
+		var name string
+		if err := json.Unmarshal(properties["name"], &name); err != nil || name == "" {
+			return nil, fmt.Errorf("component %d has no name", i)
+		}
+		if names[name] {
+			return nil, fmt.Errorf("component %s is defined more than once", name)
+		}
+		names[name] = true
+
+		for _, key := range readOnlyComponentKeys {
+			delete(properties, key)
+		}
+		result = append(result, componentDefinition{Name: name, Properties: properties})
+	}
+	return result, nil
+}
+
+// requestBody returns the body of the request to create or update the
+// component.
+func (c componentDefinition) requestBody() ([]byte, error) {
+	return json.Marshal(map[string]any{"component": c.Properties})
+}
+
+// value returns the component as a decoded JSON value.
+func (c componentDefinition) value() any {
+	raw, _ := json.Marshal(c.Properties)
+	var result any
+	_ = json.Unmarshal(raw, &result)
+	return result
+}
+
+// equal returns whether both components have the same properties.
+func (c componentDefinition) equal(other componentDefinition) bool {
+	return reflect.DeepEqual(c.value(), other.value())
+}
+
+// renderComponentsJSON returns the components in the JSON format of the
+// Storyblok CLI.
+func renderComponentsJSON(components []componentDefinition) (string, error) {
+	properties := make([]map[string]json.RawMessage, len(components))
+	for i, component := range components {
+		properties[i] = component.Properties
+	}
+	raw, err := json.Marshal(map[string]any{"components": properties})
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+// appliedComponents returns the components as they are in Storyblok after a
+// partial update: the desired components which are applied, the previous
+// version of the other existing components, and the removed components which
+// are not deleted yet.
+func appliedComponents(desired, previous []componentDefinition, applied map[string]componentDefinition, ids map[string]int64) []componentDefinition {
+	previousByName := componentsByName(previous)
+	result := []componentDefinition{}
+	seen := map[string]bool{}
+	for _, component := range desired {
+		if component, ok := applied[component.Name]; ok {
+			result = append(result, component)
+			seen[component.Name] = true
+			continue
+		}
+		if existing, ok := previousByName[component.Name]; ok {
+			if _, ok := ids[component.Name]; ok {
+				result = append(result, existing)
+				seen[component.Name] = true
+			}
+		}
+	}
+	for _, component := range previous {
+		if _, ok := ids[component.Name]; ok && !seen[component.Name] {
+			result = append(result, component)
+		}
+	}
+	return result
+}
+
+// componentsByName returns the components by name.
+func componentsByName(components []componentDefinition) map[string]componentDefinition {
+	result := make(map[string]componentDefinition, len(components))
+	for _, component := range components {
+		result[component.Name] = component
+	}
+	return result
+}
+
+// componentIDs returns the IDs of the managed components by name.
+func (m *componentSetResourceModel) componentIDs() map[string]int64 {
+	result := map[string]int64{}
+	for name, value := range m.Components.Elements() {
+		if id, ok := value.(types.Int64); ok && !id.IsNull() && !id.IsUnknown() {
+			result[name] = id.ValueInt64()
+		}
+	}
+	return result
+}
+
+// setComponentIDs sets the IDs of the managed components by name.
+func (m *componentSetResourceModel) setComponentIDs(ids map[string]int64) {
+	elements := make(map[string]attr.Value, len(ids))
+	for name, id := range ids {
+		elements[name] = types.Int64Value(id)
+	}
+	m.Components = types.MapValueMust(types.Int64Type, elements)
+}
+
+// fromRemote updates the model with the components in Storyblok. Only the
+// properties which are set in the JSON are compared, and the JSON is kept as
+// is when the components are equal, so properties which are added by
+// Storyblok don't result in a diff. Components which no longer exist are
+// left out, so they are created again.
+func (m *componentSetResourceModel) fromRemote(remote []map[string]any) error {
+	desired, err := parseComponentsJSON(m.JSON.ValueString())
+	if err != nil {
+		return err
+	}
+
+	ids := m.componentIDs()
+	byID := make(map[int64]map[string]any, len(remote))
+	byName := make(map[string]map[string]any, len(remote))
+	for _, component := range remote {
+		if id, ok := component["id"].(float64); ok {
+			byID[int64(id)] = component
+		}
+		if name, ok := component["name"].(string); ok {
+			byName[name] = component
+		}
+	}
+
+	current := make([]any, 0, len(desired))
+	projected := make([]any, 0, len(desired))
+	found := map[string]int64{}
+	for _, component := range desired {
+		remoteComponent, ok := byID[ids[component.Name]]
+		if !ok {
+			if remoteComponent, ok = byName[component.Name]; !ok {
+				continue
+			}
+		}
+		if id, ok := remoteComponent["id"].(float64); ok {
+			found[component.Name] = int64(id)
+		}
+
+		value := component.value()
+		current = append(current, value)
+		projected = append(projected, utils.ProjectJSON(value, remoteComponent))
+	}
+
+	m.setComponentIDs(found)
+	if len(current) == len(desired) && reflect.DeepEqual(current, projected) {
+		return nil
+	}
+
+	raw, err := json.Marshal(map[string]any{"components": projected})
+	if err != nil {
+		return err
+	}
+	m.JSON = types.StringValue(string(raw))
+	return nil
+}
@@ -0,0 +1,113 @@
+package component
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testComponentsJSON = `{
+	"components": [
+		{
+			"id": 1001,
+			"name": "page",
+			"created_at": "2024-01-01T00:00:00.000Z",
+			"is_root": true,
+			"schema": {
+				"title": {"type": "text", "pos": 0},
+				"body": {"type": "bloks", "pos": 1}
+			}
+		},
+		{
+			"id": 1002,
+			"name": "teaser",
+			"is_nestable": true,
+			"schema": {}
+		}
+	]
+}`
+
+func TestParseComponentsJSON(t *testing.T) {
+	components, err := parseComponentsJSON(testComponentsJSON)
+	require.NoError(t, err)
+	require.Len(t, components, 2)
+
+	assert.Equal(t, "page", components[0].Name)
+	assert.NotContains(t, components[0].Properties, "id")
+	assert.NotContains(t, components[0].Properties, "created_at")
+
+	body, err := components[0].requestBody()
+	require.NoError(t, err)
+	assert.Less(t, strings.Index(string(body), `"title"`), strings.Index(string(body), `"body"`),
+		"the order of the fields should be kept")
+
+	list, err := parseComponentsJSON(`[{"name": "page"}]`)
+	require.NoError(t, err)
+	assert.Equal(t, "page", list[0].Name)
+
+	_, err = parseComponentsJSON(`{"components": [{"is_root": true}]}`)
+	assert.EqualError(t, err, "component 0 has no name")
+
+	_, err = parseComponentsJSON(`[{"name": "page"}, {"name": "page"}]`)
+	assert.EqualError(t, err, "component page is defined more than once")
+}
+
+func TestComponentSetResourceModel_FromRemote(t *testing.T) {
+	model := &componentSetResourceModel{JSON: types.StringValue(testComponentsJSON)}
+	model.setComponentIDs(map[string]int64{"page": 2001, "teaser": 2002})
+
+	remote := []map[string]any{
+		{
+			"id": 2001.0, "name": "page", "is_root": true, "created_at": "2024-02-01T00:00:00.000Z",
+			"schema": map[string]any{
+				"title": map[string]any{"type": "text", "pos": 0.0, "id": "abc"},
+				"body":  map[string]any{"type": "bloks", "pos": 1.0},
+			},
+		},
+		{"id": 2002.0, "name": "teaser", "is_nestable": true, "schema": map[string]any{}},
+	}
+	require.NoError(t, model.fromRemote(remote))
+	assert.Equal(t, testComponentsJSON, model.JSON.ValueString(),
+		"the JSON should be kept when the components are equal")
+	assert.Equal(t, map[string]int64{"page": 2001, "teaser": 2002}, model.componentIDs())
+
+	remote[1]["is_nestable"] = false
+	require.NoError(t, model.fromRemote(remote[1:]))
+	assert.JSONEq(t, `{"components": [{"name": "teaser", "is_nestable": false, "schema": {}}]}`,
+		model.JSON.ValueString())
+	assert.Equal(t, map[string]int64{"teaser": 2002}, model.componentIDs())
+}
+
+func TestAppliedComponents(t *testing.T) {
+	previous, err := parseComponentsJSON(`[
+		{"name": "page", "is_root": false},
+		{"name": "teaser", "is_nestable": true},
+		{"name": "legacy"}
+	]`)
+	require.NoError(t, err)
+	desired, err := parseComponentsJSON(`[
+		{"name": "page", "is_root": true},
+		{"name": "teaser", "is_nestable": false},
+		{"name": "hero"},
+		{"name": "footer"}
+	]`)
+	require.NoError(t, err)
+
+	// The page is updated and the hero is created, the update of the teaser
+	// failed, so the footer isn't created and the legacy component is not
+	// deleted
+	applied := map[string]componentDefinition{"page": desired[0], "hero": desired[2]}
+	ids := map[string]int64{"page": 1, "teaser": 2, "legacy": 3, "hero": 4}
+
+	value, err := renderComponentsJSON(appliedComponents(desired, previous, applied, ids))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"components": [
+		{"name": "page", "is_root": true},
+		{"name": "teaser", "is_nestable": true},
+		{"name": "hero"},
+		{"name": "legacy"}
+	]}`, value)
+}
@@ -0,0 +1,397 @@
+package component
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/storyblok-go-sdk/sbmgmt"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &componentSetResource{}
+	_ resource.ResourceWithConfigure      = &componentSetResource{}
+	_ resource.ResourceWithModifyPlan     = &componentSetResource{}
+	_ resource.ResourceWithValidateConfig = &componentSetResource{}
+)
+
+// NewComponentSetResource is a helper function to simplify the provider implementation.
+func NewComponentSetResource() resource.Resource {
+	return &componentSetResource{}
+}
+
+// componentSetResource is the resource implementation.
+type componentSetResource struct {
+	client sbmgmt.ClientWithResponsesInterface
+	api    *mapi.Client
+}
+
+// Metadata returns the data source type name.
+func (r *componentSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_component_set"
+}
+
+// Schema defines the schema for the data source.
+func (r *componentSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A set of components defined in the JSON format of the Storyblok CLI, as written by " +
+			"`storyblok pull-components`. Every component in the JSON is managed by this resource: components " +
+			"are matched by name, and components which are removed from the JSON are deleted. The components " +
+			"should not be managed by `storyblok_component` resources as well.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The terraform ID of the component set, which is the ID of the space.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": schema.Int64Attribute{
+				Description: "The ID of the space.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"json": schema.StringAttribute{
+				Description: "The components in the JSON format of the Storyblok CLI, for example " +
+					"`file(\"components.123456.json\")`. Both an object with a list of `components` and " +
+					"a list of components are supported. The properties which are set by Storyblok, " +
+					"like `id` and `created_at`, are ignored. Only the properties which are set are " +
+					"compared with the components in Storyblok.",
+				Required: true,
+			},
+			"components": schema.MapAttribute{
+				Description: "The IDs of the managed components by name.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Prevent deleting components while they are used by stories, both when " +
+					"components are removed from the JSON and when the component set is destroyed. " +
+					"Defaults to `true`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *componentSetResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = utils.GetClient(req.ProviderData)
+	r.api = utils.GetAPIClient(req.ProviderData)
+}
+
+// ValidateConfig checks that the JSON contains valid components.
+func (r *componentSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config componentSetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.JSON.IsNull() || config.JSON.IsUnknown() {
+		return
+	}
+	if _, err := parseComponentsJSON(config.JSON.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("json"), "Invalid components JSON",
+			"Could not read the components: "+err.Error())
+	}
+}
+
+// ModifyPlan keeps the IDs of the components when no components are added or
+// removed.
+func (r *componentSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state componentSetResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan componentSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.JSON.IsUnknown() {
+		return
+	}
+
+	desired, err := parseComponentsJSON(plan.JSON.ValueString())
+	if err != nil {
+		return
+	}
+	if req.State.Raw.IsNull() || !state.SpaceID.Equal(plan.SpaceID) {
+		return
+	}
+
+	ids := state.componentIDs()
+	planned := componentsByName(desired)
+	if len(ids) != len(planned) {
+		return
+	}
+	for name := range planned {
+		if _, ok := ids[name]; !ok {
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("components"), state.Components)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *componentSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan componentSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, err := parseComponentsJSON(plan.JSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating component set",
+			"Could not read the components: "+err.Error(),
+		)
+		return
+	}
+
+	spaceID := plan.SpaceID.ValueInt64()
+	ids := map[string]int64{}
+	for _, component := range desired {
+		id, d := r.createComponent(ctx, spaceID, component)
+		if d != nil {
+			resp.Diagnostics.Append(d)
+			break
+		}
+		ids[component.Name] = id
+	}
+
+	// The state is set when a component could not be created as well, so the
+	// components which are created are tracked
+	plan.ID = types.StringValue(strconv.FormatInt(spaceID, 10))
+	plan.setComponentIDs(ids)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *componentSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state componentSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := state.SpaceID.ValueInt64()
+	content, err := r.client.ListComponentsWithResponse(ctx, spaceID)
+	if d := utils.CheckGetError("components of space", spaceID, content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	// The components are read from the response body, since the SDK drops the
+	// properties it doesn't know about
+	var response struct {
+		Components []map[string]any `json:"components"`
+	}
+	if err := json.Unmarshal(content.Body, &response); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading component set",
+			"Could not read the components of space "+strconv.FormatInt(spaceID, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	if err := state.fromRemote(response.Components); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading component set",
+			"Could not read the components of space "+strconv.FormatInt(spaceID, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *componentSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan, state componentSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, err := parseComponentsJSON(plan.JSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating component set",
+			"Could not read the components: "+err.Error(),
+		)
+		return
+	}
+	current, err := parseComponentsJSON(state.JSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating component set",
+			"Could not read the components: "+err.Error(),
+		)
+		return
+	}
+
+	spaceID := plan.SpaceID.ValueInt64()
+	ids := state.componentIDs()
+	planned := componentsByName(desired)
+	previous := componentsByName(current)
+
+	// Delete the components which are removed from the JSON first, so
+	// components can be replaced by a component with another name
+	for _, name := range utils.SortedKeys(ids) {
+		if _, ok := planned[name]; ok {
+			continue
+		}
+		if d := r.deleteComponent(ctx, spaceID, name, ids[name], plan.DeletionProtection); d != nil {
+			resp.Diagnostics.Append(d)
+			break
+		}
+		delete(ids, name)
+	}
+
+	applied := map[string]componentDefinition{}
+	if !resp.Diagnostics.HasError() {
+		for _, component := range desired {
+			id, ok := ids[component.Name]
+			if !ok {
+				id, d := r.createComponent(ctx, spaceID, component)
+				if d != nil {
+					resp.Diagnostics.Append(d)
+					break
+				}
+				ids[component.Name] = id
+				applied[component.Name] = component
+				continue
+			}
+
+			if existing, ok := previous[component.Name]; ok && existing.equal(component) {
+				applied[component.Name] = component
+				continue
+			}
+			if d := r.updateComponent(ctx, spaceID, id, component); d != nil {
+				resp.Diagnostics.Append(d)
+				break
+			}
+			applied[component.Name] = component
+		}
+	}
+
+	// The IDs are stored when not all components could be updated as well, so
+	// the components which are created or deleted are tracked. The JSON then
+	// has the components as they are applied, so the created components are
+	// kept in the state and the others are updated with the next apply.
+	plan.setComponentIDs(ids)
+	if resp.Diagnostics.HasError() {
+		value, err := renderComponentsJSON(appliedComponents(desired, current, applied, ids))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating component set",
+				"Could not render the applied components: "+err.Error(),
+			)
+			value = state.JSON.ValueString()
+		}
+		plan.JSON = types.StringValue(value)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *componentSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state componentSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := state.SpaceID.ValueInt64()
+	ids := state.componentIDs()
+	for _, name := range utils.SortedKeys(ids) {
+		if d := r.deleteComponent(ctx, spaceID, name, ids[name], state.DeletionProtection); d != nil {
+			resp.Diagnostics.Append(d)
+			return
+		}
+	}
+}
+
+// createComponent creates the component and returns its ID.
+func (r *componentSetResource) createComponent(ctx context.Context, spaceID int64, component componentDefinition) (int64, diag.Diagnostic) {
+	body, err := component.requestBody()
+	if err != nil {
+		return 0, diag.NewErrorDiagnostic("Error creating component "+component.Name, err.Error())
+	}
+
+	content, err := r.client.CreateComponentWithBodyWithResponse(
+		ctx, spaceID, "application/json", bytes.NewReader(body))
+	if d := utils.CheckCreateError("component "+component.Name, content, err); d != nil {
+		return 0, d
+	}
+	return content.JSON201.Component.Id, nil
+}
+
+// updateComponent updates the component.
+func (r *componentSetResource) updateComponent(ctx context.Context, spaceID int64, id int64, component componentDefinition) diag.Diagnostic {
+	body, err := component.requestBody()
+	if err != nil {
+		return diag.NewErrorDiagnostic("Error updating component "+component.Name, err.Error())
+	}
+
+	content, err := r.client.UpdateComponentWithBodyWithResponse(
+		ctx, spaceID, id, "application/json", bytes.NewReader(body))
+	if d := utils.CheckUpdateError("component "+component.Name, content, err); d != nil {
+		return d
+	}
+	return nil
+}
+
+// deleteComponent deletes the component, unless it is used by stories and
+// deletion protection is enabled.
+func (r *componentSetResource) deleteComponent(ctx context.Context, spaceID int64, name string, id int64, protection types.Bool) diag.Diagnostic {
+	if !protection.Equal(types.BoolValue(false)) {
+		if d := checkUnused(ctx, r.api, spaceID, name); d != nil {
+			return d
+		}
+	}
+
+	content, err := r.client.DeleteComponentWithResponse(ctx, spaceID, id)
+	if d := utils.CheckDeleteError(fmt.Sprintf("component %s", name), content, err); d != nil {
+		return d
+	}
+	return nil
+}
@@ -0,0 +1,379 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// commonFieldAttributes are the attributes that apply to fields of all types.
+var commonFieldAttributes = []string{
+	"type", "position", "display_name", "description", "tooltip", "required", "translatable", "no_translate",
+	"default_value", "conditional_settings", "can_sync", "exclude_from_merge", "exclude_from_overwrite",
+	"force_merge", "renamed_from", "extra_json",
+}
+
+// optionAttributes are the attributes of the single and multi option fields.
+var optionAttributes = []string{
+	"options", "source", "datasource_slug", "external_datasource", "folder_slug", "filter_content_type",
+	"use_uuid", "exclude_empty_option", "entry_appearance", "is_reference_type", "restrict_content_types",
+}
+
+// fieldTypeAttributes are the attributes that apply to fields of a type, in
+// addition to the common attributes. Custom fields are not listed, as their
+// options depend on the plugin.
+var fieldTypeAttributes = map[string][]string{
+	"bloks": {
+		"component_whitelist", "component_group_whitelist", "component_tag_whitelist", "restrict_components",
+		"restrict_type", "minimum", "maximum",
+	},
+	"text":     {"max_length", "regex", "rtl"},
+	"textarea": {"max_length", "regex", "rtl"},
+	"markdown": {"max_length", "rtl", "rich_markdown", "customize_toolbar", "toolbar", "allow_multiline"},
+	"richtext": {
+		"max_length", "rtl", "customize_toolbar", "toolbar", "component_whitelist", "component_group_whitelist",
+		"component_tag_whitelist", "restrict_components", "restrict_type", "allow_target_blank",
+		"allow_custom_attributes", "link_scope", "force_link_scope",
+	},
+	"number":   {"minimum", "maximum", "min_value", "max_value", "decimals", "steps"},
+	"datetime": {"disable_time"},
+	"boolean":  {"inline_label"},
+	"option":   optionAttributes,
+	"options":  append([]string{"min_options", "max_options"}, optionAttributes...),
+	"asset": {
+		"filetypes", "asset_folder_id", "allow_external_url", "add_https", "image_crop", "image_height",
+		"image_width", "keep_image_size",
+	},
+	"multiasset": {"filetypes", "asset_folder_id", "allow_external_url", "add_https"},
+	"multilink": {
+		"component_whitelist", "restrict_content_types", "filter_content_type", "folder_slug", "link_scope", "force_link_scope",
+		"allow_target_blank", "email_link_type", "asset_link_type", "show_anchor", "allow_advanced_search",
+		"allow_custom_attributes", "allow_external_url",
+	},
+	"section": {"keys"},
+	"tab":     {"keys"},
+	"image": {
+		"asset_folder_id", "add_https", "image_crop", "image_height", "image_width", "keep_image_size",
+	},
+	"file":  {"filetypes", "asset_folder_id", "add_https"},
+	"table": {},
+}
+
+// fieldsKnown returns whether the fields, tabs and sections of the component
+// are fully known. The model uses maps and slices for them, so the
+// configuration or plan can only be decoded into the model when they are.
+func fieldsKnown(raw tftypes.Value) bool {
+	for _, name := range []string{"schema", "fields", "tabs", "sections"} {
+		value, _, err := tftypes.WalkAttributePath(raw, tftypes.NewAttributePath().WithAttributeName(name))
+		if err != nil {
+			return false
+		}
+		if v, ok := value.(tftypes.Value); !ok || !v.IsFullyKnown() {
+			return false
+		}
+	}
+	return true
+}
+
+// irrelevantFieldAttributes returns the attributes which have no effect for
+// the type of field, in sorted order. It returns nothing for unknown types.
+func irrelevantFieldAttributes(fieldType string, attributes []string) []string {
+	valid, ok := fieldTypeAttributes[fieldType]
+	if !ok {
+		return nil
+	}
+
+	result := []string{}
+	for _, name := range attributes {
+		if !pie.Contains(commonFieldAttributes, name) && !pie.Contains(valid, name) {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// fieldAttributesValidator warns about field attributes which don't apply to
+// the type of the field, as Storyblok silently ignores them.
+type fieldAttributesValidator struct{}
+
+var _ resource.ConfigValidator = fieldAttributesValidator{}
+
+func (v fieldAttributesValidator) Description(_ context.Context) string {
+	return "Field attributes must apply to the type of the field"
+}
+
+func (v fieldAttributesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v fieldAttributesValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var schema types.Map
+	var list types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("schema"), &schema)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("fields"), &list)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := map[string]types.Object{}
+	paths := map[string]path.Path{}
+	for name, value := range schema.Elements() {
+		if field, ok := value.(types.Object); ok {
+			fields[name] = field
+			paths[name] = path.Root("schema").AtMapKey(name)
+		}
+	}
+	for i, value := range list.Elements() {
+		field, ok := value.(types.Object)
+		if !ok || field.IsNull() || field.IsUnknown() {
+			continue
+		}
+		if name, ok := field.Attributes()["name"].(types.String); ok && !name.IsUnknown() {
+			fields[name.ValueString()] = field
+			paths[name.ValueString()] = path.Root("fields").AtListIndex(i)
+		}
+	}
+
+	for _, name := range utils.SortedKeys(fields) {
+		field := fields[name]
+		if field.IsNull() || field.IsUnknown() {
+			continue
+		}
+
+		attributes := field.Attributes()
+		delete(attributes, "name")
+		fieldType, ok := attributes["type"].(types.String)
+		if !ok || fieldType.IsNull() || fieldType.IsUnknown() {
+			continue
+		}
+
+		for _, attribute := range irrelevantFieldAttributes(fieldType.ValueString(), setAttributes(attributes)) {
+			resp.Diagnostics.AddAttributeWarning(
+				paths[name].AtName(attribute),
+				"Attribute not supported by field type",
+				fmt.Sprintf("Attribute %s has no effect on field %s of type %s and is ignored by Storyblok.",
+					attribute, name, fieldType.ValueString()),
+			)
+		}
+	}
+}
+
+// setAttributes returns the names of the attributes which are set.
+func setAttributes(attributes map[string]attr.Value) []string {
+	result := []string{}
+	for name, value := range attributes {
+		if !value.IsNull() {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// conditionalSettingsValidator checks that the rule conditions of fields
+// refer to other fields of the component, as rules referring to a missing
+// field silently never match.
+type conditionalSettingsValidator struct{}
+
+var _ resource.ConfigValidator = conditionalSettingsValidator{}
+
+func (v conditionalSettingsValidator) Description(_ context.Context) string {
+	return "Conditional settings must refer to other fields of the component"
+}
+
+func (v conditionalSettingsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v conditionalSettingsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if !fieldsKnown(req.Config.Raw) {
+		return
+	}
+
+	var config componentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := config.fieldSchema()
+	for _, name := range utils.SortedKeys(fields) {
+		for i, setting := range fields[name].ConditionalSettings {
+			for j, condition := range setting.RuleConditions {
+				conditionPath := config.fieldPath(name).AtName("conditional_settings").AtListIndex(i).
+					AtName("rule_conditions").AtListIndex(j)
+
+				validation := condition.Validation.ValueString()
+				if (validation == "equals" || validation == "not_equals") && condition.Value.IsNull() {
+					resp.Diagnostics.AddAttributeError(conditionPath.AtName("value"), "Missing rule condition value",
+						fmt.Sprintf("Field %s has a rule condition with validation %s, which requires a value.",
+							name, validation))
+				}
+
+				fieldKey := condition.ValidatedObject.FieldKey
+				if fieldKey.IsNull() || fieldKey.IsUnknown() {
+					continue
+				}
+				keyPath := conditionPath.AtName("validated_object").AtName("field_key")
+				if fieldKey.ValueString() == name {
+					resp.Diagnostics.AddAttributeError(keyPath, "Invalid rule condition",
+						fmt.Sprintf("Field %s has a rule condition on itself.", name))
+					continue
+				}
+				if _, ok := fields[fieldKey.ValueString()]; !ok {
+					resp.Diagnostics.AddAttributeError(keyPath, "Invalid rule condition",
+						fmt.Sprintf("Field %s has a rule condition on field %s, which is not part of the schema.",
+							name, fieldKey.ValueString()))
+				}
+			}
+		}
+	}
+}
+
+// positionsValidator checks that the fields of tabs and sections exist, that
+// positions are only set on fields which are not part of a tab or section and
+// that positions and field names are unique.
+type positionsValidator struct{}
+
+var _ resource.ConfigValidator = positionsValidator{}
+
+func (v positionsValidator) Description(_ context.Context) string {
+	return "Fields must have a unique position unless they are part of a tab or section"
+}
+
+func (v positionsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v positionsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if !fieldsKnown(req.Config.Raw) {
+		return
+	}
+
+	var config componentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := map[string]bool{}
+	for i, field := range config.Fields {
+		if field.Name.IsUnknown() {
+			continue
+		}
+		if names[field.Name.ValueString()] {
+			resp.Diagnostics.AddAttributeError(path.Root("fields").AtListIndex(i).AtName("name"), "Duplicate field",
+				fmt.Sprintf("Field %s is defined more than once.", field.Name.ValueString()))
+		}
+		names[field.Name.ValueString()] = true
+	}
+
+	fields := config.fieldSchema()
+	groups := map[string]string{}
+	sections := map[string]bool{}
+	for i, section := range config.Sections {
+		sectionPath := path.Root("sections").AtListIndex(i)
+		if section.Key.IsUnknown() {
+			continue
+		}
+		key := section.Key.ValueString()
+		if _, ok := fields[key]; ok {
+			resp.Diagnostics.AddAttributeError(sectionPath.AtName("key"), "Invalid section",
+				fmt.Sprintf("Section %s has the same key as a field of the schema.", key))
+		}
+		sections[key] = true
+
+		for _, field := range section.Fields {
+			if field.IsUnknown() {
+				continue
+			}
+			name := field.ValueString()
+			if _, ok := fields[name]; !ok {
+				resp.Diagnostics.AddAttributeError(sectionPath.AtName("fields"), "Invalid section",
+					fmt.Sprintf("Section %s contains field %s, which is not part of the schema.", key, name))
+			}
+			if other, ok := groups[name]; ok {
+				resp.Diagnostics.AddAttributeError(sectionPath.AtName("fields"), "Invalid section",
+					fmt.Sprintf("Field %s is part of both %s and section %s.", name, other, key))
+			}
+			groups[name] = "section " + key
+		}
+	}
+
+	tabs := map[string]string{}
+	for i, tab := range config.Tabs {
+		tabPath := path.Root("tabs").AtListIndex(i)
+		if tab.Key.IsUnknown() {
+			continue
+		}
+		key := tab.Key.ValueString()
+		if _, ok := fields[key]; ok {
+			resp.Diagnostics.AddAttributeError(tabPath.AtName("key"), "Invalid tab",
+				fmt.Sprintf("Tab %s has the same key as a field of the schema.", key))
+		}
+		if sections[key] {
+			resp.Diagnostics.AddAttributeError(tabPath.AtName("key"), "Invalid tab",
+				fmt.Sprintf("Tab %s has the same key as a section.", key))
+		}
+
+		for _, field := range tab.Fields {
+			if field.IsUnknown() {
+				continue
+			}
+			name := field.ValueString()
+			_, isField := fields[name]
+			if !isField && !sections[name] {
+				resp.Diagnostics.AddAttributeError(tabPath.AtName("fields"), "Invalid tab",
+					fmt.Sprintf("Tab %s contains field %s, which is neither part of the schema nor a section.", key, name))
+			}
+			if other, ok := tabs[name]; ok {
+				resp.Diagnostics.AddAttributeError(tabPath.AtName("fields"), "Invalid tab",
+					fmt.Sprintf("Field %s is part of both tab %s and tab %s.", name, other, key))
+			} else if other, ok := groups[name]; ok {
+				resp.Diagnostics.AddAttributeError(tabPath.AtName("fields"), "Invalid tab",
+					fmt.Sprintf("Field %s is part of both %s and tab %s.", name, other, key))
+			}
+			tabs[name] = key
+			if isField {
+				groups[name] = "tab " + key
+			}
+		}
+	}
+
+	// The positions of the ordered list of fields follow from their order
+	if config.Fields != nil {
+		return
+	}
+
+	positions := map[int64]string{}
+	for _, name := range utils.SortedKeys(config.Schema) {
+		position := config.Schema[name].Position
+		positionPath := path.Root("schema").AtMapKey(name).AtName("position")
+		group, grouped := groups[name]
+		switch {
+		case grouped && !position.IsNull():
+			resp.Diagnostics.AddAttributeError(positionPath, "Invalid position",
+				fmt.Sprintf("Field %s is part of %s, its position follows from the order of the tabs and "+
+					"sections.", name, group))
+		case !grouped && position.IsNull():
+			resp.Diagnostics.AddAttributeError(positionPath, "Missing position",
+				fmt.Sprintf("Field %s must have a position, as it is not part of a tab or section.", name))
+		case !grouped && !position.IsUnknown():
+			if other, ok := positions[position.ValueInt64()]; ok {
+				resp.Diagnostics.AddAttributeError(positionPath, "Duplicate position",
+					fmt.Sprintf("Fields %s and %s both have position %d, positions must be unique.",
+						other, name, position.ValueInt64()))
+			}
+			positions[position.ValueInt64()] = name
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package component
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIrrelevantFieldAttributes(t *testing.T) {
+	assert.Equal(t, []string{"max_options", "toolbar"},
+		irrelevantFieldAttributes("text", []string{"type", "position", "toolbar", "max_length", "max_options"}))
+	assert.Equal(t, []string{"max_length"},
+		irrelevantFieldAttributes("number", []string{"type", "maximum", "max_length", "required"}))
+	assert.Empty(t, irrelevantFieldAttributes("options", []string{"type", "max_options", "source", "filter_content_type"}))
+	assert.Empty(t, irrelevantFieldAttributes("custom", []string{"type", "toolbar"}), "plugin options should not be validated")
+}
+
+func TestFieldTypeAttributesExist(t *testing.T) {
+	resp := &resource.SchemaResponse{}
+	NewComponentResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	fields, ok := resp.Schema.Attributes["schema"].(schema.MapNestedAttribute)
+	require.True(t, ok)
+	attributes := fields.NestedObject.Attributes
+
+	for _, name := range commonFieldAttributes {
+		assert.Contains(t, attributes, name)
+	}
+	for fieldType, names := range fieldTypeAttributes {
+		assert.Contains(t, getComponentTypes(), fieldType)
+		for _, name := range names {
+			assert.Contains(t, attributes, name, "attribute of type %s", fieldType)
+		}
+	}
+	for fieldType := range getComponentTypes() {
+		if fieldType != "custom" {
+			assert.Contains(t, fieldTypeAttributes, fieldType)
+		}
+	}
+}
+
+func TestValidatorsUnknownFields(t *testing.T) {
+	ctx := context.Background()
+	r := &componentResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["name"] = tftypes.NewValue(tftypes.String, "page")
+	values["space_id"] = tftypes.NewValue(tftypes.Number, 233252)
+	values["schema"] = tftypes.NewValue(objectType.AttributeTypes["schema"], tftypes.UnknownValue)
+	raw := tftypes.NewValue(objectType, values)
+
+	req := resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}}
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(ctx, req, resp)
+	for _, v := range r.ConfigValidators(ctx) {
+		v.ValidateResource(ctx, req, resp)
+	}
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	planResp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw}}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw},
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}, planResp)
+	assert.False(t, planResp.Diagnostics.HasError(), "%v", planResp.Diagnostics)
+}
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -30,7 +31,7 @@ func TestComponentResourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(rn, "schema.title.type", "text"),
 					resource.TestCheckResourceAttr(rn, "schema.intro.position", "2"),
 					resource.TestCheckResourceAttr(rn, "schema.intro.type", "text"),
-					resource.TestCheckResourceAttr(rn, "schema.image.position", "3"),
+					resource.TestCheckResourceAttr(rn, "schema.image.position", "4"),
 					resource.TestCheckResourceAttr(rn, "schema.image.type", "image"),
 					resource.TestCheckResourceAttr(rn, "schema.image.conditional_settings.0.modifications.0.required", "true"),
 					resource.TestCheckResourceAttr(rn, "schema.image.conditional_settings.0.rule_match", "all"),
@@ -58,13 +59,13 @@ func TestComponentResourceBasic(t *testing.T) {
 				Config: testComponentConfigUpdate(id, spaceId),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(rn, "name", "new-test-banner"),
-					resource.TestCheckResourceAttr(rn, "schema.intro.position", "1"),
+					resource.TestCheckResourceAttr(rn, "schema.intro.position", "2"),
 					resource.TestCheckResourceAttr(rn, "schema.intro.type", "text"),
-					resource.TestCheckResourceAttr(rn, "schema.title.position", "2"),
+					resource.TestCheckResourceAttr(rn, "schema.title.position", "3"),
 					resource.TestCheckResourceAttr(rn, "schema.title.type", "text"),
 					resource.TestCheckResourceAttr(rn, "schema.buttons.filter_content_type.0", "button"),
 					resource.TestCheckResourceAttr(rn, "schema.buttons.type", "options"),
-					resource.TestCheckResourceAttr(rn, "schema.buttons.position", "3"),
+					resource.TestCheckResourceAttr(rn, "schema.buttons.position", "4"),
 					resource.TestCheckResourceAttr(rn, "schema.link.description", "Other link"),
 					resource.TestCheckResourceAttr(rn, "schema.link.translatable", "false"),
 					resource.TestCheckResourceAttr(rn, "schema.link.required", "false"),
@@ -85,6 +86,371 @@ func TestComponentResourceBasic(t *testing.T) {
 	})
 }
 
+func TestComponentResourceDeletionProtection(t *testing.T) {
+	f, stop := ProviderFactories("./assets/component_deletion_protection")
+	defer func() {
+		_ = stop()
+	}()
+
+	rn := "storyblok_component.teaser"
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config: testComponentDeletionProtectionConfig(spaceId, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testComponentDeletionProtectionConfig(spaceId, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`it is used by 2 stories: home,\s+blog/first-post`),
+			},
+			{
+				Config: testComponentDeletionProtectionConfig(spaceId, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestComponentResourceRenamedField(t *testing.T) {
+	f, stop := ProviderFactories("./assets/component_renamed_field")
+	defer func() {
+		_ = stop()
+	}()
+
+	rn := "storyblok_component.teaser"
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config: testComponentRenamedFieldConfig(spaceId, "headline", "text", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "schema.headline.type", "text"),
+				),
+			},
+			{
+				Config:      testComponentRenamedFieldConfig(spaceId, "title", "text", ""),
+				ExpectError: regexp.MustCompile(`Field headline is removed from component teaser`),
+			},
+			{
+				Config:      testComponentRenamedFieldConfig(spaceId, "headline", "number", ""),
+				ExpectError: regexp.MustCompile(`The type of field headline of component teaser changes\s+from text to\s+number`),
+			},
+			{
+				Config:      testComponentRenamedFieldConfig(spaceId, "title", "text", "title"),
+				ExpectError: regexp.MustCompile(`Field title is renamed from title, but title is still part of the\s+schema`),
+			},
+			{
+				Config: testComponentRenamedFieldConfig(spaceId, "title", "text", "headline"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(rn, "schema.headline.type"),
+					resource.TestCheckResourceAttr(rn, "schema.title.type", "text"),
+					resource.TestCheckResourceAttr(rn, "schema.title.renamed_from", "headline"),
+				),
+			},
+		},
+	})
+}
+
+func TestComponentResourceConditionalSettings(t *testing.T) {
+	f, stop := ProviderFactories("./assets/component_conditional_settings")
+	defer func() {
+		_ = stop()
+	}()
+
+	rn := "storyblok_component.teaser"
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config:      testComponentConditionalSettingsConfig(spaceId, "not_empty", "headline", ""),
+				ExpectError: regexp.MustCompile(`Field image has a rule condition on field headline, which is not part of the\s+schema`),
+			},
+			{
+				Config:      testComponentConditionalSettingsConfig(spaceId, "not_empty", "image", ""),
+				ExpectError: regexp.MustCompile(`Field image has a rule condition on itself`),
+			},
+			{
+				Config:      testComponentConditionalSettingsConfig(spaceId, "equals", "layout", ""),
+				ExpectError: regexp.MustCompile(`Field image has a rule condition with validation equals, which requires a\s+value`),
+			},
+			{
+				Config: testComponentConditionalSettingsConfig(spaceId, "equals", "layout", "text"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "schema.image.conditional_settings.0.rule_conditions.0.validated_object.field_key", "layout"),
+					resource.TestCheckResourceAttr(rn, "schema.image.conditional_settings.0.rule_conditions.0.value", "text"),
+				),
+			},
+		},
+	})
+}
+
+func testComponentConditionalSettingsConfig(spaceId int, validation string, fieldKey string, value string) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_component" "teaser" {
+		  space_id            = {{ .spaceId }}
+		  name                = "teaser"
+		  is_nestable         = true
+		  deletion_protection = false
+
+		  schema = {
+		    layout = {
+		      type     = "text"
+		      position = 1
+		    }
+
+		    image = {
+		      type     = "asset"
+		      position = 2
+
+		      conditional_settings = [
+		        {
+		          modifications = [{ display = "hide" }]
+		          rule_match    = "all"
+		          rule_conditions = [
+		            {
+		              validation       = "{{ .validation }}"
+		              validated_object = { field_key = "{{ .fieldKey }}" }
+		              {{ if .value }}value = "{{ .value }}"{{ end }}
+		            }
+		          ]
+		        }
+		      ]
+		    }
+		  }
+		}
+	`, map[string]any{
+		"spaceId":    spaceId,
+		"validation": validation,
+		"fieldKey":   fieldKey,
+		"value":      value,
+	})
+}
+
+func TestComponentResourceTabs(t *testing.T) {
+	f, stop := ProviderFactories("./assets/component_tabs")
+	defer func() {
+		_ = stop()
+	}()
+
+	rn := "storyblok_component.article"
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config:      testComponentTabsConfig(spaceId, "tab-seo", "tab-media", "position = 3"),
+				ExpectError: regexp.MustCompile(`Field\s+seo_title\s+is\s+part\s+of\s+tab\s+tab-seo,\s+its\s+position\s+follows\s+from\s+the\s+order\s+of\s+the\s+tabs\s+and\s+sections`),
+			},
+			{
+				Config: testComponentTabsConfig(spaceId, "tab-seo", "tab-media", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "tabs.#", "2"),
+					resource.TestCheckResourceAttr(rn, "tabs.0.key", "tab-seo"),
+					resource.TestCheckResourceAttr(rn, "tabs.0.display_name", "SEO"),
+					resource.TestCheckResourceAttr(rn, "tabs.0.fields.#", "2"),
+					resource.TestCheckResourceAttr(rn, "tabs.0.fields.0", "seo_title"),
+					resource.TestCheckResourceAttr(rn, "tabs.1.key", "tab-media"),
+					resource.TestCheckNoResourceAttr(rn, "schema.seo_title.position"),
+					resource.TestCheckNoResourceAttr(rn, "schema.tab-seo.type"),
+				),
+			},
+			{
+				Config: testComponentTabsConfig(spaceId, "tab-media", "tab-seo", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "tabs.0.key", "tab-media"),
+					resource.TestCheckResourceAttr(rn, "tabs.1.key", "tab-seo"),
+				),
+			},
+		},
+	})
+}
+
+func testComponentTabsConfig(spaceId int, firstTab string, secondTab string, seoPosition string) string {
+	return utils.HCLTemplate(`
+		locals {
+		  tabs = {
+		    "tab-seo"   = { key = "tab-seo", display_name = "SEO", fields = ["seo_title", "seo_description"] }
+		    "tab-media" = { key = "tab-media", display_name = "Media", fields = ["image"] }
+		  }
+		}
+
+		resource "storyblok_component" "article" {
+		  space_id            = {{ .spaceId }}
+		  name                = "article"
+		  is_root             = true
+		  deletion_protection = false
+
+		  schema = {
+		    title = {
+		      type     = "text"
+		      position = 1
+		    }
+		    seo_title = {
+		      type = "text"
+		      {{ .seoPosition }}
+		    }
+		    seo_description = {
+		      type = "textarea"
+		    }
+		    image = {
+		      type = "asset"
+		    }
+		  }
+
+		  tabs = [local.tabs["{{ .firstTab }}"], local.tabs["{{ .secondTab }}"]]
+		}
+	`, map[string]any{
+		"spaceId":     spaceId,
+		"firstTab":    firstTab,
+		"secondTab":   secondTab,
+		"seoPosition": seoPosition,
+	})
+}
+
+func TestComponentResourceFields(t *testing.T) {
+	f, stop := ProviderFactories("./assets/component_fields")
+	defer func() {
+		_ = stop()
+	}()
+
+	rn := "storyblok_component.quote"
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config: utils.HCLTemplate(`
+					resource "storyblok_component" "quote" {
+					  space_id = {{ .spaceId }}
+					  name     = "quote"
+
+					  schema = {
+					    text = {
+					      type     = "textarea"
+					      position = 1
+					    }
+					    author = {
+					      type     = "text"
+					      position = 1
+					    }
+					  }
+					}
+				`, map[string]any{"spaceId": spaceId}),
+				ExpectError: regexp.MustCompile(`Fields author and text both have position 1, positions must be unique`),
+			},
+			{
+				Config: testComponentFieldsConfig(spaceId, "text", "author"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "fields.#", "2"),
+					resource.TestCheckResourceAttr(rn, "fields.0.name", "text"),
+					resource.TestCheckResourceAttr(rn, "fields.0.position", "1"),
+					resource.TestCheckResourceAttr(rn, "fields.1.name", "author"),
+					resource.TestCheckResourceAttr(rn, "fields.1.position", "2"),
+					resource.TestCheckNoResourceAttr(rn, "schema.%"),
+				),
+			},
+			{
+				Config: testComponentFieldsConfig(spaceId, "author", "text"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "fields.0.name", "author"),
+					resource.TestCheckResourceAttr(rn, "fields.0.position", "1"),
+					resource.TestCheckResourceAttr(rn, "fields.1.name", "text"),
+					resource.TestCheckResourceAttr(rn, "fields.1.position", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testComponentFieldsConfig(spaceId int, first string, second string) string {
+	return utils.HCLTemplate(`
+		locals {
+		  fields = {
+		    text   = { name = "text", type = "textarea", required = true }
+		    author = { name = "author", type = "text" }
+		  }
+		}
+
+		resource "storyblok_component" "quote" {
+		  space_id            = {{ .spaceId }}
+		  name                = "quote"
+		  is_nestable         = true
+		  deletion_protection = false
+
+		  fields = [local.fields["{{ .first }}"], local.fields["{{ .second }}"]]
+		}
+	`, map[string]any{
+		"spaceId": spaceId,
+		"first":   first,
+		"second":  second,
+	})
+}
+
+func testComponentRenamedFieldConfig(spaceId int, field string, fieldType string, renamedFrom string) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_component" "teaser" {
+		  space_id            = {{ .spaceId }}
+		  name                = "teaser"
+		  is_nestable         = true
+		  deletion_protection = false
+		  protect_fields      = true
+
+		  schema = {
+		    {{ .field }} = {
+		      type     = "{{ .fieldType }}"
+		      position = 1
+		      {{ if .renamedFrom }}renamed_from = "{{ .renamedFrom }}"{{ end }}
+		    }
+		  }
+		}
+	`, map[string]any{
+		"spaceId":     spaceId,
+		"field":       field,
+		"fieldType":   fieldType,
+		"renamedFrom": renamedFrom,
+	})
+}
+
+func testComponentDeletionProtectionConfig(spaceId int, protected bool) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_component" "teaser" {
+		  space_id            = {{ .spaceId }}
+		  name                = "teaser"
+		  is_nestable         = true
+		  deletion_protection = {{ .protected }}
+
+		  schema = {
+		    headline = {
+		      type     = "text"
+		      position = 1
+		    }
+		  }
+		}
+	`, map[string]any{
+		"spaceId":   spaceId,
+		"protected": protected,
+	})
+}
+
 func testComponentConfig(identifier string, spaceId int) string {
 	return utils.HCLTemplate(`
 		resource "storyblok_component" "{{ .identifier }}" {
@@ -122,7 +488,7 @@ func testComponentConfig(identifier string, spaceId int) string {
 			
 				image = {
 					type     = "image"
-					position = 3
+					position = 4
 
 					conditional_settings = [
 						{
@@ -161,7 +527,7 @@ func testComponentConfigUpdate(identifier string, spaceId int) string {
 		  schema = {
 			title = {
 			  type     = "text"
-			  position = 2
+			  position = 3
 			}
 	
 			link = {
@@ -185,13 +551,13 @@ func testComponentConfigUpdate(identifier string, spaceId int) string {
 		
 			intro = {
 			  type     = "text"
-			  position = 1
+			  position = 2
 			}
 
 			buttons = {
 				type = "options"
 				source = "internal_stories"
-				position = 3
+				position = 4
 				filter_content_type = ["button"]
 			}
 		  }
@@ -202,3 +568,115 @@ func testComponentConfigUpdate(identifier string, spaceId int) string {
 		"spaceId":    spaceId,
 	})
 }
+
+func TestComponentResourceExtraJSON(t *testing.T) {
+	f, stop := ProviderFactories("./assets/component_extra_json")
+	defer func() {
+		_ = stop()
+	}()
+
+	rn := "storyblok_component.article"
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config: utils.HCLTemplate(`
+					resource "storyblok_component" "article" {
+					  space_id   = {{ .spaceId }}
+					  name       = "article"
+					  extra_json = "[1, 2]"
+
+					  schema = {
+					    title = {
+					      type     = "text"
+					      position = 1
+					    }
+					  }
+					}
+				`, map[string]any{"spaceId": spaceId}),
+				ExpectError: regexp.MustCompile(`value must be a JSON encoded object`),
+			},
+			{
+				Config: utils.HCLTemplate(`
+					resource "storyblok_component" "article" {
+					  space_id   = {{ .spaceId }}
+					  name       = "article"
+					  extra_json = jsonencode({ schema = {} })
+
+					  schema = {
+					    title = {
+					      type     = "text"
+					      position = 1
+					    }
+					  }
+					}
+				`, map[string]any{"spaceId": spaceId}),
+				ExpectError: regexp.MustCompile(`The schema can't be set in the extra_json of the component`),
+			},
+			{
+				Config: utils.HCLTemplate(`
+					resource "storyblok_component" "article" {
+					  space_id = {{ .spaceId }}
+					  name     = "article"
+
+					  schema = {
+					    title = {
+					      type       = "text"
+					      position   = 1
+					      required   = false
+					      extra_json = jsonencode({ required = true })
+					    }
+					  }
+					}
+				`, map[string]any{"spaceId": spaceId}),
+				ExpectError: regexp.MustCompile(`The key required can't be set in the extra_json`),
+			},
+			{
+				Config: testComponentExtraJSONConfig(spaceId, "marketing", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "extra_json", `{"metadata":{"owner":"marketing"}}`),
+					resource.TestCheckResourceAttr(rn, "schema.title.extra_json", `{"ai_assist":{"enabled":true}}`),
+					resource.TestCheckNoResourceAttr(rn, "schema.intro.extra_json"),
+				),
+			},
+			{
+				Config: testComponentExtraJSONConfig(spaceId, "editorial", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "extra_json", `{"metadata":{"owner":"editorial"}}`),
+					resource.TestCheckResourceAttr(rn, "schema.title.extra_json", `{"ai_assist":{"enabled":false}}`),
+				),
+			},
+		},
+	})
+}
+
+func testComponentExtraJSONConfig(spaceId int, owner string, aiAssist bool) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_component" "article" {
+		  space_id            = {{ .spaceId }}
+		  name                = "article"
+		  is_root             = true
+		  deletion_protection = false
+		  extra_json          = jsonencode({ metadata = { owner = "{{ .owner }}" } })
+
+		  schema = {
+		    title = {
+		      type       = "text"
+		      position   = 1
+		      extra_json = jsonencode({ ai_assist = { enabled = {{ .aiAssist }} } })
+		    }
+		    intro = {
+		      type     = "textarea"
+		      position = 2
+		    }
+		  }
+		}
+	`, map[string]any{
+		"spaceId":  spaceId,
+		"owner":    owner,
+		"aiAssist": aiAssist,
+	})
+}
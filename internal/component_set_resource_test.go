@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+func TestComponentSetResource(t *testing.T) {
+	f, stop := ProviderFactories("./assets/component_set")
+	defer func() {
+		_ = stop()
+	}()
+
+	rn := "storyblok_component_set.test"
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config: utils.HCLTemplate(`
+					resource "storyblok_component_set" "test" {
+					  space_id = {{ .spaceId }}
+					  json     = jsonencode([{ name = "page" }, { name = "page" }])
+					}
+				`, map[string]any{"spaceId": spaceId}),
+				ExpectError: regexp.MustCompile(`component page is defined more than once`),
+			},
+			{
+				Config: testComponentSetConfig(spaceId, "Page", "teaser"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "id", "233252"),
+					resource.TestCheckResourceAttr(rn, "components.%", "2"),
+					resource.TestCheckResourceAttrSet(rn, "components.page"),
+					resource.TestCheckResourceAttrSet(rn, "components.teaser"),
+				),
+			},
+			{
+				Config: testComponentSetConfig(spaceId, "Landing page", "hero"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "components.%", "2"),
+					resource.TestCheckResourceAttrSet(rn, "components.page"),
+					resource.TestCheckResourceAttrSet(rn, "components.hero"),
+					resource.TestCheckNoResourceAttr(rn, "components.teaser"),
+				),
+			},
+		},
+	})
+}
+
+func testComponentSetConfig(spaceId int, displayName string, nested string) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_component_set" "test" {
+		  space_id = {{ .spaceId }}
+		  json = jsonencode({
+		    components = [
+		      {
+		        id           = 1234
+		        name         = "page"
+		        display_name = "{{ .displayName }}"
+		        created_at   = "2024-01-01T00:00:00.000Z"
+		        is_root      = true
+		        is_nestable  = false
+		        schema = {
+		          title = { type = "text", pos = 0 }
+		          body  = { type = "bloks", pos = 1, component_whitelist = ["{{ .nested }}"] }
+		        }
+		      },
+		      {
+		        name        = "{{ .nested }}"
+		        is_root     = false
+		        is_nestable = true
+		        schema = {
+		          headline = { type = "text", pos = 0 }
+		        }
+		      },
+		    ]
+		  })
+		}
+	`, map[string]any{
+		"spaceId":     spaceId,
+		"displayName": displayName,
+		"nested":      nested,
+	})
+}
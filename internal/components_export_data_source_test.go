@@ -0,0 +1,56 @@
+package internal
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+func TestComponentsExportDataSource(t *testing.T) {
+	f, stop := ProviderFactories("./assets/components_export")
+	defer func() {
+		_ = stop()
+	}()
+
+	spaceId := 233252
+	dn := "data.storyblok_components_export.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config: utils.HCLTemplate(`
+					resource "storyblok_component" "article" {
+					  space_id            = {{ .spaceId }}
+					  name                = "article"
+					  is_root             = true
+					  deletion_protection = false
+
+					  schema = {
+					    title = {
+					      type     = "text"
+					      position = 1
+					    }
+					  }
+					}
+
+					data "storyblok_components_export" "test" {
+					  space_id   = {{ .spaceId }}
+					  names      = ["article"]
+					  depends_on = [storyblok_component.article]
+					}
+				`, map[string]any{"spaceId": spaceId}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dn, "id", "233252"),
+					resource.TestMatchResourceAttr(dn, "json", regexp.MustCompile(`^\{\n  "components": \[\n    \{`)),
+					resource.TestMatchResourceAttr(dn, "json", regexp.MustCompile(`"name": "article"`)),
+					resource.TestMatchResourceAttr(dn, "json", regexp.MustCompile(`"title": \{\n\s+"pos": 1,\n\s+"type": "text"`)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,127 @@
+package content
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+const storiesPerPage = 100
+
+// MigrateStories applies the transform to the bloks of the component in all
+// stories that use it and returns the stories that changed. The stories get a
+// new draft. With publish set, stories that are published without unpublished
+// changes are published again. With dryRun set the stories are not updated.
+func MigrateStories(
+	ctx context.Context, client *mapi.Client, spaceID int64, component string, transform Transform,
+	dryRun bool, publish bool,
+) ([]mapi.Story, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	stories, d := ListStories(ctx, client, spaceID, component)
+	if d != nil {
+		diags.Append(d)
+		return nil, diags
+	}
+
+	changed := []mapi.Story{}
+	for _, s := range stories {
+		story, ok, d := transformStory(ctx, client, spaceID, s.Id, component, transform)
+		if d != nil {
+			diags.Append(d)
+			return changed, diags
+		}
+		if !ok {
+			continue
+		}
+		changed = append(changed, story)
+		if dryRun {
+			continue
+		}
+
+		input := mapi.StoryContentInput{}
+		input.Story.Content = story.Content
+		if publish && story.Published && !story.UnpublishedChanges {
+			input.Publish = 1
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("migrating content of story %s", story.FullSlug))
+		updated, err := client.UpdateStoryContent(ctx, spaceID, story.Id, input)
+		if d := utils.CheckUpdateError("story "+story.FullSlug, updated, err); d != nil {
+			diags.Append(d)
+			return changed, diags
+		}
+	}
+
+	return changed, diags
+}
+
+// PreviewStories returns the stories that change by the transform, without
+// updating them, and the number of stories that use the component. Only the
+// content of the first limit stories is checked, as the content of each story
+// is retrieved separately.
+func PreviewStories(
+	ctx context.Context, client *mapi.Client, spaceID int64, component string, transform Transform, limit int,
+) ([]mapi.Story, int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	stories, d := ListStories(ctx, client, spaceID, component)
+	if d != nil {
+		diags.Append(d)
+		return nil, 0, diags
+	}
+
+	changed := []mapi.Story{}
+	for i, s := range stories {
+		if i == limit {
+			break
+		}
+		story, ok, d := transformStory(ctx, client, spaceID, s.Id, component, transform)
+		if d != nil {
+			diags.Append(d)
+			return changed, len(stories), diags
+		}
+		if ok {
+			changed = append(changed, story)
+		}
+	}
+	return changed, len(stories), diags
+}
+
+// transformStory retrieves the story and applies the transform to its
+// content. It returns whether the content changed.
+func transformStory(
+	ctx context.Context, client *mapi.Client, spaceID int64, id int64, component string, transform Transform,
+) (mapi.Story, bool, diag.Diagnostic) {
+	content, err := client.GetStory(ctx, spaceID, id)
+	if d := utils.CheckGetError("story", id, content, err); d != nil {
+		return mapi.Story{}, false, d
+	}
+
+	story := content.JSON.Story
+	return story, Apply(story.Content, component, transform), nil
+}
+
+// ListStories returns all stories that use the component.
+func ListStories(ctx context.Context, client *mapi.Client, spaceID int64, component string) ([]mapi.Story, diag.Diagnostic) {
+	result := []mapi.Story{}
+	for page := 1; ; page++ {
+		content, err := client.ListStories(ctx, spaceID, mapi.ListStoriesParams{
+			ContainComponent: component,
+			Page:             page,
+			PerPage:          storiesPerPage,
+		})
+		if d := utils.CheckGetError("stories of space", spaceID, content, err); d != nil {
+			return nil, d
+		}
+		result = append(result, content.JSON.Stories...)
+		if len(content.JSON.Stories) < storiesPerPage {
+			return result, nil
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+)
+
+// migrationResourceModel maps the resource schema data.
+type migrationResourceModel struct {
+	ID              types.String     `tfsdk:"id"`
+	SpaceID         types.Int64      `tfsdk:"space_id"`
+	Name            types.String     `tfsdk:"name"`
+	Component       types.String     `tfsdk:"component"`
+	DryRun          types.Bool       `tfsdk:"dry_run"`
+	Publish         types.Bool       `tfsdk:"publish_migrated_stories"`
+	Transforms      []transformModel `tfsdk:"transforms"`
+	MigratedStories types.List       `tfsdk:"migrated_stories"`
+	MigratedAt      types.String     `tfsdk:"migrated_at"`
+}
+
+type transformModel struct {
+	RenameField *renameFieldModel `tfsdk:"rename_field"`
+	WrapValue   *wrapValueModel   `tfsdk:"wrap_value"`
+	MapOptions  *mapOptionsModel  `tfsdk:"map_options"`
+	MoveToBlok  *moveToBlokModel  `tfsdk:"move_to_blok"`
+}
+
+type renameFieldModel struct {
+	From types.String `tfsdk:"from"`
+	To   types.String `tfsdk:"to"`
+}
+
+type wrapValueModel struct {
+	Field types.String `tfsdk:"field"`
+	Type  types.String `tfsdk:"type"`
+}
+
+type mapOptionsModel struct {
+	Field  types.String            `tfsdk:"field"`
+	Values map[string]types.String `tfsdk:"values"`
+}
+
+type moveToBlokModel struct {
+	Fields    []types.String `tfsdk:"fields"`
+	Field     types.String   `tfsdk:"field"`
+	Component types.String   `tfsdk:"component"`
+}
+
+func (m *migrationResourceModel) identifier() string {
+	return fmt.Sprintf("%d/%s", m.SpaceID.ValueInt64(), m.Name.ValueString())
+}
+
+// transform returns the transforms of the migration chained in the declared
+// order.
+func (m *migrationResourceModel) transform() Transform {
+	transforms := make([]Transform, 0, len(m.Transforms))
+	for _, t := range m.Transforms {
+		switch {
+		case t.RenameField != nil:
+			transforms = append(transforms, RenameField(t.RenameField.From.ValueString(), t.RenameField.To.ValueString()))
+		case t.WrapValue != nil:
+			transforms = append(transforms, WrapValue(t.WrapValue.Field.ValueString(), t.WrapValue.Type.ValueString()))
+		case t.MapOptions != nil:
+			values := make(map[string]string, len(t.MapOptions.Values))
+			for from, to := range t.MapOptions.Values {
+				values[from] = to.ValueString()
+			}
+			transforms = append(transforms, MapOptions(t.MapOptions.Field.ValueString(), values))
+		case t.MoveToBlok != nil:
+			fields := make([]string, 0, len(t.MoveToBlok.Fields))
+			for _, field := range t.MoveToBlok.Fields {
+				fields = append(fields, field.ValueString())
+			}
+			transforms = append(transforms, MoveToBlok(fields, t.MoveToBlok.Field.ValueString(), t.MoveToBlok.Component.ValueString()))
+		}
+	}
+	return Chain(transforms...)
+}
+
+func (m *migrationResourceModel) setMigratedStories(ctx context.Context, stories []mapi.Story) diag.Diagnostics {
+	slugs := make([]string, 0, len(stories))
+	for _, story := range stories {
+		slugs = append(slugs, story.FullSlug)
+	}
+
+	var diags diag.Diagnostics
+	m.MigratedStories, diags = types.ListValueFrom(ctx, types.StringType, slugs)
+	return diags
+}
+
+// storySlugs returns the slugs of the stories as a readable list, limited to
+// the first stories.
+func storySlugs(stories []mapi.Story, limit int) string {
+	slugs := []string{}
+	for i, story := range stories {
+		if i == limit {
+			slugs = append(slugs, fmt.Sprintf("and %d more", len(stories)-limit))
+			break
+		}
+		slugs = append(slugs, story.FullSlug)
+	}
+	return strings.Join(slugs, ", ")
+}
@@ -0,0 +1,38 @@
+package content
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+)
+
+func TestMigrationResourceModel_Transform(t *testing.T) {
+	model := &migrationResourceModel{
+		SpaceID: types.Int64Value(123),
+		Name:    types.StringValue("teaser-layout"),
+		Transforms: []transformModel{
+			{RenameField: &renameFieldModel{From: types.StringValue("style"), To: types.StringValue("layout")}},
+			{WrapValue: &wrapValueModel{Field: types.StringValue("layout"), Type: types.StringValue(WrapList)}},
+			{MapOptions: &mapOptionsModel{
+				Field:  types.StringValue("layout"),
+				Values: map[string]types.String{"big": types.StringValue("wide")},
+			}},
+		},
+	}
+	blok := map[string]any{"component": "teaser", "style": "big"}
+
+	assert.Equal(t, "123/teaser-layout", model.identifier())
+	assert.True(t, model.transform()(blok))
+	assert.Equal(t, map[string]any{"component": "teaser", "layout": []any{"wide"}}, blok)
+	assert.False(t, model.transform()(blok), "a migration should not change migrated content")
+}
+
+func TestStorySlugs(t *testing.T) {
+	stories := []mapi.Story{{FullSlug: "home"}, {FullSlug: "about"}, {FullSlug: "blog/first-post"}}
+
+	assert.Equal(t, "home, about, blog/first-post", storySlugs(stories, 3))
+	assert.Equal(t, "home, and 2 more", storySlugs(stories, 1))
+}
@@ -0,0 +1,381 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &migrationResource{}
+	_ resource.ResourceWithConfigure      = &migrationResource{}
+	_ resource.ResourceWithModifyPlan     = &migrationResource{}
+	_ resource.ResourceWithValidateConfig = &migrationResource{}
+)
+
+// planStoriesLimit is the number of stories listed in the plan output.
+const planStoriesLimit = 10
+
+// planCheckLimit is the number of stories whose content is checked in the
+// plan, as the content of each story is retrieved separately.
+const planCheckLimit = 25
+
+// NewMigrationResource is a helper function to simplify the provider implementation.
+func NewMigrationResource() resource.Resource {
+	return &migrationResource{}
+}
+
+// migrationResource is the resource implementation.
+type migrationResource struct {
+	client *mapi.Client
+}
+
+// Metadata returns the data source type name.
+func (r *migrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_migration"
+}
+
+// Schema defines the schema for the data source.
+func (r *migrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	requiredString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Migrates the content of all stories using a component, for example after changing the type " +
+			"of a field. The transforms run once when the resource is created and the stories that would change " +
+			"are shown in the plan, which checks the first " + strconv.Itoa(planCheckLimit) + " stories using the " +
+			"component. Changing any argument creates a new migration, which runs again. All " +
+			"transforms skip content that is already migrated, so a migration which failed halfway can safely " +
+			"run again. Destroying the resource doesn't revert the content.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The terraform ID of the migration, which is the space ID and the name of the migration.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": schema.Int64Attribute{
+				Description: "The ID of the space.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the migration, which marks the migration as done in the state.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"component": schema.StringAttribute{
+				Description: "The technical name of the component whose bloks are migrated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"dry_run": schema.BoolAttribute{
+				Description: "Only determine the stories that would change, without updating them. Default is false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"publish_migrated_stories": schema.BoolAttribute{
+				Description: "Publish the migrated stories again when they were published without unpublished " +
+					"changes. Otherwise the migrated content is saved as a draft. Default is false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"transforms": schema.ListNestedAttribute{
+				Description: "The transforms to apply to each blok of the component, in order. Each transform " +
+					"sets exactly one of the attributes.",
+				Required: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rename_field": schema.SingleNestedAttribute{
+							Description: "Moves the value of a field to a new key, including translated values.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"from": requiredString("The old key of the field."),
+								"to":   requiredString("The new key of the field."),
+							},
+						},
+						"wrap_value": schema.SingleNestedAttribute{
+							Description: "Wraps the value of a field for a field type that holds a larger structure. " +
+								"Use `list` when an `option` field becomes an `options` field and `richtext` when a " +
+								"`text` or `textarea` field becomes a `richtext` field.",
+							Optional: true,
+							Attributes: map[string]schema.Attribute{
+								"field": requiredString("The key of the field."),
+								"type": schema.StringAttribute{
+									Description: "The structure to wrap the value in, either `list` or `richtext`.",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(WrapList, WrapRichtext),
+									},
+								},
+							},
+						},
+						"map_options": schema.SingleNestedAttribute{
+							Description: "Replaces option values of a field, for single values and lists of values.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"field": requiredString("The key of the field."),
+								"values": schema.MapAttribute{
+									Description: "The new value by old value.",
+									Required:    true,
+									ElementType: types.StringType,
+									Validators: []validator.Map{
+										mapvalidator.SizeAtLeast(1),
+									},
+								},
+							},
+						},
+						"move_to_blok": schema.SingleNestedAttribute{
+							Description: "Moves fields into a new nested blok, which is added to a blocks field.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"fields": schema.ListAttribute{
+									Description: "The keys of the fields to move.",
+									Required:    true,
+									ElementType: types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtLeast(1),
+									},
+								},
+								"field":     requiredString("The key of the blocks field to add the nested blok to."),
+								"component": requiredString("The technical name of the component of the nested blok."),
+							},
+						},
+					},
+				},
+			},
+			"migrated_stories": schema.ListAttribute{
+				Description: "The full slugs of the stories that were changed by the migration.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"migrated_at": schema.StringAttribute{
+				Description: "The time the migration ran.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *migrationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = utils.GetAPIClient(req.ProviderData)
+}
+
+// ValidateConfig checks that each transform sets exactly one kind of
+// transform and that the transforms can safely run again.
+func (r *migrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config migrationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, t := range config.Transforms {
+		attrPath := path.Root("transforms").AtListIndex(i)
+
+		count := 0
+		for _, set := range []bool{t.RenameField != nil, t.WrapValue != nil, t.MapOptions != nil, t.MoveToBlok != nil} {
+			if set {
+				count++
+			}
+		}
+		if count != 1 {
+			resp.Diagnostics.AddAttributeError(attrPath, "Invalid transform",
+				"A transform must set exactly one of rename_field, wrap_value, map_options or move_to_blok.")
+			continue
+		}
+
+		if t.MoveToBlok != nil && !t.MoveToBlok.Component.IsUnknown() && !config.Component.IsUnknown() &&
+			t.MoveToBlok.Component.ValueString() == config.Component.ValueString() {
+			resp.Diagnostics.AddAttributeError(attrPath.AtName("move_to_blok").AtName("component"),
+				"Invalid nested blok",
+				fmt.Sprintf("Fields can't be moved into a nested %s blok, as the migration transforms the %s bloks. "+
+					"Running the migration again would nest the fields once more.",
+					config.Component.ValueString(), config.Component.ValueString()))
+		}
+
+		if t.MapOptions != nil {
+			for from, to := range t.MapOptions.Values {
+				if _, ok := t.MapOptions.Values[to.ValueString()]; ok && !to.IsUnknown() && to.ValueString() != from {
+					resp.Diagnostics.AddAttributeError(attrPath.AtName("map_options").AtName("values"),
+						"Invalid option mapping",
+						fmt.Sprintf("Value %s is mapped to %s, which is mapped again. Chained mappings can't "+
+							"safely run again, map %s directly to the final value.", from, to.ValueString(), from))
+				}
+			}
+		}
+	}
+}
+
+// ModifyPlan shows the stories that will change when the migration is
+// created, checking the first planCheckLimit stories.
+func (r *migrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var plan migrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stories, total, diags := PreviewStories(ctx, r.client, plan.SpaceID.ValueInt64(), plan.Component.ValueString(),
+		plan.transform(), planCheckLimit)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	action := "changes"
+	if plan.DryRun.ValueBool() {
+		action = "would change (dry run)"
+	}
+	switch {
+	case total > planCheckLimit:
+		summary := fmt.Sprintf("%d of the first %d stories", len(stories), planCheckLimit)
+		if len(stories) > 0 {
+			summary += ": " + storySlugs(stories, planStoriesLimit)
+		}
+		resp.Diagnostics.AddWarning("Content migration",
+			fmt.Sprintf("Migration %s of component %s %s %s. %d stories use the component, the other stories "+
+				"are checked when the migration is applied.", plan.Name.ValueString(), plan.Component.ValueString(),
+				action, summary, total))
+	case len(stories) > 0:
+		resp.Diagnostics.AddWarning("Content migration",
+			fmt.Sprintf("Migration %s of component %s %s %d stories: %s", plan.Name.ValueString(),
+				plan.Component.ValueString(), action, len(stories), storySlugs(stories, planStoriesLimit)))
+	}
+}
+
+// Create runs the migration and sets the initial Terraform state.
+func (r *migrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan migrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stories, diags := MigrateStories(ctx, r.client, plan.SpaceID.ValueInt64(), plan.Component.ValueString(),
+		plan.transform(), plan.DryRun.ValueBool(), plan.Publish.ValueBool())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("migration %s changed %d stories", plan.Name.ValueString(), len(stories)))
+
+	plan.ID = types.StringValue(plan.identifier())
+	plan.MigratedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	resp.Diagnostics.Append(plan.setMigratedStories(ctx, stories)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read keeps the Terraform state, as a migration has no remote state.
+func (r *migrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state migrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is not used as every change replaces the migration.
+func (r *migrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan migrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the Terraform state, the migrated content is kept.
+func (r *migrationResource) Delete(ctx context.Context, req resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Info(ctx, "removing content migration from state, the migrated content is kept")
+}
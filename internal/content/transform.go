@@ -0,0 +1,228 @@
+// Package content migrates the content of stories when the schema of a
+// component changes.
+package content
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/gofrs/uuid"
+)
+
+// Transform changes a single blok of a component and reports whether the blok
+// was changed.
+type Transform func(blok map[string]any) bool
+
+// Apply applies the transform to every blok of the component in the content,
+// including bloks nested in other bloks or in richtext fields. The nested
+// bloks are transformed before their parent, so bloks added by the transform
+// are not transformed again.
+func Apply(content any, component string, transform Transform) bool {
+	changed := false
+	switch value := content.(type) {
+	case map[string]any:
+		for _, child := range value {
+			if Apply(child, component, transform) {
+				changed = true
+			}
+		}
+		if name, ok := value["component"].(string); ok && name == component {
+			if transform(value) {
+				changed = true
+			}
+		}
+	case []any:
+		for _, child := range value {
+			if Apply(child, component, transform) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// i18nSeparator separates the field name from the language in the keys of
+// translated field values, for example `title__i18n__de`.
+const i18nSeparator = "__i18n__"
+
+// RenameField moves the value of a field to a new key, together with the
+// translated values of the field. Bloks which already have a value for the
+// new key are left untouched.
+func RenameField(from string, to string) Transform {
+	return func(blok map[string]any) bool {
+		if _, ok := blok[to]; ok {
+			return false
+		}
+
+		keys := make([]string, 0, len(blok))
+		for key := range blok {
+			keys = append(keys, key)
+		}
+
+		changed := false
+		for _, key := range keys {
+			var target string
+			switch {
+			case key == from:
+				target = to
+			case strings.HasPrefix(key, from+i18nSeparator):
+				target = to + strings.TrimPrefix(key, from)
+			default:
+				continue
+			}
+			if _, exists := blok[target]; exists {
+				continue
+			}
+			blok[target] = blok[key]
+			delete(blok, key)
+			changed = true
+		}
+		return changed
+	}
+}
+
+// Chain applies the transforms in order and reports whether any of them
+// changed the blok.
+func Chain(transforms ...Transform) Transform {
+	return func(blok map[string]any) bool {
+		changed := false
+		for _, transform := range transforms {
+			if transform(blok) {
+				changed = true
+			}
+		}
+		return changed
+	}
+}
+
+// Wrap types supported by WrapValue.
+const (
+	WrapList     = "list"
+	WrapRichtext = "richtext"
+)
+
+// WrapValue wraps the value of a field for a field type that holds a larger
+// structure: `list` turns a single value into a list, as needed when an
+// `option` field becomes an `options` field, and `richtext` turns a text into
+// a richtext document. Values that are already wrapped are left untouched.
+func WrapValue(field string, wrap string) Transform {
+	return func(blok map[string]any) bool {
+		changed := false
+		for _, key := range fieldKeys(blok, field) {
+			var wrapped any
+			switch value := blok[key].(type) {
+			case nil:
+				continue
+			case string:
+				if wrap == WrapRichtext {
+					wrapped = richtextDocument(value)
+				} else if value == "" {
+					wrapped = []any{}
+				} else {
+					wrapped = []any{value}
+				}
+			case []any, map[string]any:
+				continue
+			default:
+				if wrap == WrapRichtext {
+					continue
+				}
+				wrapped = []any{value}
+			}
+			blok[key] = wrapped
+			changed = true
+		}
+		return changed
+	}
+}
+
+// richtextDocument returns a richtext document with a paragraph per line of
+// the text.
+func richtextDocument(text string) map[string]any {
+	paragraphs := []any{}
+	for _, line := range strings.Split(text, "\n") {
+		paragraph := map[string]any{"type": "paragraph"}
+		if line != "" {
+			paragraph["content"] = []any{map[string]any{"type": "text", "text": line}}
+		}
+		paragraphs = append(paragraphs, paragraph)
+	}
+	return map[string]any{"type": "doc", "content": paragraphs}
+}
+
+// MapOptions replaces option values of a field, both for single values and
+// lists of values. Values without a mapping are kept.
+func MapOptions(field string, values map[string]string) Transform {
+	return func(blok map[string]any) bool {
+		changed := false
+		for _, key := range fieldKeys(blok, field) {
+			switch value := blok[key].(type) {
+			case string:
+				if mapped, ok := values[value]; ok && mapped != value {
+					blok[key] = mapped
+					changed = true
+				}
+			case []any:
+				for i, item := range value {
+					s, ok := item.(string)
+					if !ok {
+						continue
+					}
+					if mapped, ok := values[s]; ok && mapped != s {
+						value[i] = mapped
+						changed = true
+					}
+				}
+			}
+		}
+		return changed
+	}
+}
+
+// MoveToBlok moves fields into a new nested blok of the component, which is
+// added to the blocks field. Bloks without any of the fields are left
+// untouched.
+func MoveToBlok(fields []string, field string, component string) Transform {
+	return func(blok map[string]any) bool {
+		nested := map[string]any{
+			"_uid":      nestedUID(blok, field, component),
+			"component": component,
+		}
+
+		moved := false
+		for _, name := range fields {
+			for _, key := range fieldKeys(blok, name) {
+				nested[key] = blok[key]
+				delete(blok, key)
+				moved = true
+			}
+		}
+		if !moved {
+			return false
+		}
+
+		children, _ := blok[field].([]any)
+		blok[field] = append(children, nested)
+		return true
+	}
+}
+
+// nestedUID derives the uid of a nested blok from the uid of its parent, so
+// the same content always results in the same uid.
+func nestedUID(blok map[string]any, field string, component string) string {
+	parent, _ := blok["_uid"].(string)
+	return uuid.NewV5(uuid.NamespaceOID, parent+"/"+field+"/"+component).String()
+}
+
+// fieldKeys returns the keys holding the value of the field in the blok,
+// including translated values, in sorted order.
+func fieldKeys(blok map[string]any, field string) []string {
+	keys := []string{}
+	for key := range blok {
+		if key == field || strings.HasPrefix(key, field+i18nSeparator) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
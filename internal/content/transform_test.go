@@ -0,0 +1,139 @@
+package content
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyRenameField(t *testing.T) {
+	content := map[string]any{
+		"component": "page",
+		"body": []any{
+			map[string]any{"component": "teaser", "headline": "Welcome", "headline__i18n__de": "Willkommen"},
+			map[string]any{
+				"component": "grid",
+				"columns": []any{
+					map[string]any{"component": "teaser", "headline": "Read more"},
+				},
+			},
+		},
+		"headline": "Page headline",
+	}
+
+	changed := Apply(content, "teaser", RenameField("headline", "title"))
+
+	assert.True(t, changed)
+	assert.Equal(t, map[string]any{
+		"component": "page",
+		"body": []any{
+			map[string]any{"component": "teaser", "title": "Welcome", "title__i18n__de": "Willkommen"},
+			map[string]any{
+				"component": "grid",
+				"columns": []any{
+					map[string]any{"component": "teaser", "title": "Read more"},
+				},
+			},
+		},
+		"headline": "Page headline",
+	}, content)
+}
+
+func TestApplyRenameFieldUnchanged(t *testing.T) {
+	content := map[string]any{
+		"component": "page",
+		"body": []any{
+			map[string]any{"component": "teaser", "headline": "Old", "title": "New"},
+			map[string]any{"component": "teaser"},
+		},
+	}
+
+	changed := Apply(content, "teaser", RenameField("headline", "title"))
+
+	assert.False(t, changed)
+	assert.Equal(t, "Old", content["body"].([]any)[0].(map[string]any)["headline"])
+}
+
+func TestWrapValue(t *testing.T) {
+	blok := map[string]any{
+		"component":        "teaser",
+		"layout":           "wide",
+		"layout__i18n__de": "breit",
+		"tags":             []any{"news"},
+		"intro":            "First line\n\nSecond line",
+		"empty":            "",
+	}
+
+	assert.True(t, WrapValue("layout", WrapList)(blok))
+	assert.True(t, WrapValue("intro", WrapRichtext)(blok))
+	assert.True(t, WrapValue("empty", WrapList)(blok))
+	assert.False(t, WrapValue("tags", WrapList)(blok), "lists should not be wrapped again")
+	assert.False(t, WrapValue("missing", WrapList)(blok))
+
+	assert.Equal(t, []any{"wide"}, blok["layout"])
+	assert.Equal(t, []any{"breit"}, blok["layout__i18n__de"])
+	assert.Equal(t, []any{}, blok["empty"])
+	assert.Equal(t, map[string]any{
+		"type": "doc",
+		"content": []any{
+			map[string]any{"type": "paragraph", "content": []any{map[string]any{"type": "text", "text": "First line"}}},
+			map[string]any{"type": "paragraph"},
+			map[string]any{"type": "paragraph", "content": []any{map[string]any{"type": "text", "text": "Second line"}}},
+		},
+	}, blok["intro"])
+	assert.False(t, WrapValue("intro", WrapRichtext)(blok), "richtext should not be wrapped again")
+}
+
+func TestMapOptions(t *testing.T) {
+	blok := map[string]any{
+		"component": "teaser",
+		"color":     "red",
+		"colors":    []any{"red", "green", "blue"},
+	}
+
+	assert.True(t, MapOptions("color", map[string]string{"red": "primary"})(blok))
+	assert.True(t, MapOptions("colors", map[string]string{"red": "primary", "green": "secondary"})(blok))
+	assert.False(t, MapOptions("color", map[string]string{"red": "primary"})(blok))
+
+	assert.Equal(t, "primary", blok["color"])
+	assert.Equal(t, []any{"primary", "secondary", "blue"}, blok["colors"])
+}
+
+func TestMoveToBlok(t *testing.T) {
+	blok := map[string]any{
+		"_uid":      "b1",
+		"component": "teaser",
+		"label":     "Read more",
+		"url":       "/blog",
+		"body":      []any{map[string]any{"_uid": "c1", "component": "text"}},
+	}
+
+	transform := MoveToBlok([]string{"label", "url"}, "body", "button")
+	assert.True(t, transform(blok))
+	assert.False(t, transform(blok), "bloks without the fields should be left untouched")
+
+	assert.Equal(t, []any{
+		map[string]any{"_uid": "c1", "component": "text"},
+		map[string]any{"_uid": "06f3d81f-2762-53e5-af97-3df773ba5e82", "component": "button", "label": "Read more", "url": "/blog"},
+	}, blok["body"])
+	assert.NotContains(t, blok, "label")
+	assert.NotContains(t, blok, "url")
+}
+
+func TestApplyMoveToBlokSameComponent(t *testing.T) {
+	content := map[string]any{
+		"_uid":      "b1",
+		"component": "section",
+		"title":     "Hello",
+		"body":      []any{},
+	}
+
+	assert.True(t, Apply(content, "section", MoveToBlok([]string{"title"}, "body", "section")))
+
+	body := content["body"].([]any)
+	assert.Len(t, body, 1)
+	nested := body[0].(map[string]any)
+	assert.Equal(t, "Hello", nested["title"])
+	assert.Empty(t, nested["body"], "the added blok should not be transformed again")
+	assert.NotContains(t, content, "title")
+}
@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+func TestContentMigrationResource(t *testing.T) {
+	f, stop := ProviderFactories("./assets/content_migration")
+	defer func() {
+		_ = stop()
+	}()
+
+	rn := "storyblok_content_migration.teaser"
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config: utils.HCLTemplate(`
+					resource "storyblok_content_migration" "teaser" {
+					  space_id  = {{ .spaceId }}
+					  name      = "teaser-headline"
+					  component = "teaser"
+
+					  transforms = [
+					    {
+					      rename_field = { from = "headline", to = "title" }
+					      wrap_value   = { field = "title", type = "richtext" }
+					    }
+					  ]
+					}
+				`, map[string]any{"spaceId": spaceId}),
+				ExpectError: regexp.MustCompile(`A transform must set exactly one of rename_field, wrap_value, map_options or\s+move_to_blok`),
+			},
+			{
+				Config: testContentMigrationConfig(spaceId, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "id", "233252/teaser-headline"),
+					resource.TestCheckResourceAttr(rn, "dry_run", "true"),
+					resource.TestCheckResourceAttr(rn, "migrated_stories.#", "2"),
+					resource.TestCheckResourceAttr(rn, "migrated_stories.0", "home"),
+					resource.TestCheckResourceAttr(rn, "migrated_stories.1", "blog/first-post"),
+				),
+			},
+			{
+				Config: testContentMigrationConfig(spaceId, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "dry_run", "false"),
+					resource.TestCheckResourceAttr(rn, "migrated_stories.#", "2"),
+					resource.TestCheckResourceAttrSet(rn, "migrated_at"),
+				),
+			},
+		},
+	})
+}
+
+func testContentMigrationConfig(spaceId int, dryRun bool) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_content_migration" "teaser" {
+		  space_id  = {{ .spaceId }}
+		  name      = "teaser-headline"
+		  component = "teaser"
+		  dry_run   = {{ .dryRun }}
+
+		  transforms = [
+		    {
+		      rename_field = { from = "headline", to = "title" }
+		    },
+		    {
+		      wrap_value = { field = "title", type = "richtext" }
+		    }
+		  ]
+		}
+	`, map[string]any{
+		"spaceId": spaceId,
+		"dryRun":  dryRun,
+	})
+}
@@ -0,0 +1,51 @@
+package customvalidators
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = jsonObjectValidator{}
+
+// jsonObjectValidator validates that a string Attribute's value is a JSON
+// encoded object.
+type jsonObjectValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator jsonObjectValidator) Description(_ context.Context) string {
+	return "value must be a JSON encoded object, for example jsonencode({ key = \"value\" })"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v jsonObjectValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	var object map[string]any
+	if err := json.Unmarshal([]byte(value), &object); err != nil || object == nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+// JSONObject returns an AttributeValidator which ensures that any configured
+// attribute value is a JSON encoded object.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func JSONObject() validator.String {
+	return jsonObjectValidator{}
+}
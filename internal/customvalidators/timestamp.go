@@ -0,0 +1,50 @@
+package customvalidators
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = timestampValidator{}
+
+// timestampValidator validates that a string Attribute's value is a RFC 3339
+// timestamp.
+type timestampValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator timestampValidator) Description(_ context.Context) string {
+	return "value must be a RFC 3339 timestamp, for example 2025-03-01T09:00:00+01:00"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator timestampValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v timestampValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+// Timestamp returns an AttributeValidator which ensures that any configured
+// attribute value is a RFC 3339 timestamp.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Timestamp() validator.String {
+	return timestampValidator{}
+}
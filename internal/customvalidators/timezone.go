@@ -0,0 +1,51 @@
+package customvalidators
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = timezoneValidator{}
+
+// timezoneValidator validates that a string Attribute's value is a valid IANA
+// timezone name. The provider binary embeds time/tzdata, so the validation
+// doesn't depend on the timezone database of the host.
+type timezoneValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator timezoneValidator) Description(_ context.Context) string {
+	return "value must be a valid IANA timezone, for example Europe/Amsterdam"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator timezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v timezoneValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if _, err := time.LoadLocation(value); err != nil || value == "" || value == "Local" {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+// Timezone returns an AttributeValidator which ensures that any configured
+// attribute value is a valid IANA timezone name.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Timezone() validator.String {
+	return timezoneValidator{}
+}
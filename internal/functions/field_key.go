@@ -0,0 +1,64 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &fieldKeyFunction{}
+
+// NewFieldKeyFunction is a helper function to simplify the provider implementation.
+func NewFieldKeyFunction() function.Function {
+	return &fieldKeyFunction{}
+}
+
+// fieldKeyFunction is the function implementation.
+type fieldKeyFunction struct{}
+
+// Metadata returns the function name.
+func (f *fieldKeyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "field_key"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *fieldKeyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build the key of a field of a component",
+		Description: "Returns the key of a field in the format `<component>.<field>`, as used by the " +
+			"`field_permissions` and `readonly_field_permissions` of `storyblok_space_role`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "component",
+				Description: "The technical name of the component.",
+			},
+			function.StringParameter{
+				Name:        "field",
+				Description: "The key of the field in the schema of the component.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the field key.
+func (f *fieldKeyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var component, field string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &component, &field))
+	if resp.Error != nil {
+		return
+	}
+
+	if component == "" || strings.Contains(component, ".") {
+		resp.Error = function.NewArgumentFuncError(0, "the component name must be set and can't contain a dot")
+		return
+	}
+	if field == "" || strings.Contains(field, ".") {
+		resp.Error = function.NewArgumentFuncError(1, "the field key must be set and can't contain a dot")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, component+"."+field))
+}
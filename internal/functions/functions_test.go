@@ -0,0 +1,36 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseID(t *testing.T) {
+	spaceID, id, err := parseID("233252/1001")
+	require.NoError(t, err)
+	assert.Equal(t, int64(233252), spaceID)
+	assert.Equal(t, int64(1001), id)
+
+	_, _, err = parseID("233252")
+	assert.EqualError(t, err, `invalid ID "233252", expected <space_id>/<id>`)
+
+	_, _, err = parseID("233252/1001/1")
+	assert.Error(t, err)
+
+	_, _, err = parseID("space/1001")
+	assert.EqualError(t, err, `invalid space ID "space" in ID "space/1001"`)
+
+	_, _, err = parseID("233252/")
+	assert.EqualError(t, err, `invalid object ID "" in ID "233252/"`)
+}
+
+func TestRegionURL(t *testing.T) {
+	assert.Equal(t, "https://mapi.storyblok.com", regionURL(233252))
+	assert.Equal(t, "https://api-us.storyblok.com", regionURL(1_000_123))
+	assert.Equal(t, "https://api-ca.storyblok.com", regionURL(2_000_123))
+	assert.Equal(t, "https://api-ap.storyblok.com", regionURL(3_000_123))
+	assert.Equal(t, "https://app.storyblokchina.cn", regionURL(4_000_123))
+	assert.Equal(t, "https://mapi.storyblok.com", regionURL(286_000_123))
+}
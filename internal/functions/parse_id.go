@@ -0,0 +1,96 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &parseIDFunction{}
+
+// parseIDAttributeTypes are the attributes of the object returned by parse_id.
+var parseIDAttributeTypes = map[string]attr.Type{
+	"space_id": types.Int64Type,
+	"id":       types.Int64Type,
+}
+
+// NewParseIDFunction is a helper function to simplify the provider implementation.
+func NewParseIDFunction() function.Function {
+	return &parseIDFunction{}
+}
+
+// parseIDFunction is the function implementation.
+type parseIDFunction struct{}
+
+// Metadata returns the function name.
+func (f *parseIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_id"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *parseIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse the composite ID of a resource",
+		Description: "Returns the space ID and the ID of the object from a composite ID like `123/456`, " +
+			"as used by the `id` of the resources in this provider.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The composite ID, in the format `<space_id>/<id>`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseIDAttributeTypes,
+		},
+	}
+}
+
+// Run parses the ID.
+func (f *parseIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	spaceID, objectID, err := parseID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(parseIDAttributeTypes, map[string]attr.Value{
+		"space_id": types.Int64Value(spaceID),
+		"id":       types.Int64Value(objectID),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// parseID parses a composite ID like utils.ParseIdentifier, but fails when
+// the ID is not in the format <space_id>/<id>.
+func parseID(id string) (int64, int64, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid ID %q, expected <space_id>/<id>", id)
+	}
+
+	spaceID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid space ID %q in ID %q", parts[0], id)
+	}
+	objectID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid object ID %q in ID %q", parts[1], id)
+	}
+	return spaceID, objectID, nil
+}
@@ -0,0 +1,72 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &regionURLFunction{}
+
+// NewRegionURLFunction is a helper function to simplify the provider implementation.
+func NewRegionURLFunction() function.Function {
+	return &regionURLFunction{}
+}
+
+// regionURLFunction is the function implementation.
+type regionURLFunction struct{}
+
+// Metadata returns the function name.
+func (f *regionURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "region_url"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *regionURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Return the Management API URL of the region of a space",
+		Description: "Returns the URL of the Storyblok Management API for the region of the space, which " +
+			"follows from the ID of the space. The URL can be used as the `url` of the provider.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "space_id",
+				Description: "The ID of the space.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run returns the URL of the region.
+func (f *regionURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var spaceID int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &spaceID))
+	if resp.Error != nil {
+		return
+	}
+
+	if spaceID <= 0 {
+		resp.Error = function.NewArgumentFuncError(0, "the space ID must be a positive number")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, regionURL(spaceID)))
+}
+
+// regionURL returns the Management API URL of the region of the space. The
+// spaces outside of the EU have IDs in a range per region.
+func regionURL(spaceID int64) string {
+	switch {
+	case spaceID >= 1_000_000 && spaceID < 2_000_000:
+		return "https://api-us.storyblok.com"
+	case spaceID >= 2_000_000 && spaceID < 3_000_000:
+		return "https://api-ca.storyblok.com"
+	case spaceID >= 3_000_000 && spaceID < 4_000_000:
+		return "https://api-ap.storyblok.com"
+	case spaceID >= 4_000_000 && spaceID < 5_000_000:
+		return "https://app.storyblokchina.cn"
+	default:
+		return "https://mapi.storyblok.com"
+	}
+}
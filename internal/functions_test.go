@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// testFunctionsProviders declares the provider, which is required to call its
+// functions. The test framework serves the provider in the hashicorp namespace.
+const testFunctionsProviders = `
+	terraform {
+	  required_providers {
+	    storyblok = {
+	      source = "hashicorp/storyblok"
+	    }
+	  }
+	}
+`
+
+func TestProviderFunctions(t *testing.T) {
+	f, stop := ProviderFactories("./assets/functions")
+	defer func() {
+		_ = stop()
+	}()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config: testFunctionsProviders + `
+					output "id" {
+					  value = provider::storyblok::parse_id("233252")
+					}
+				`,
+				ExpectError: regexp.MustCompile(`invalid ID "233252", expected\s+<space_id>/<id>`),
+			},
+			{
+				Config: testFunctionsProviders + `
+					output "field_key" {
+					  value = provider::storyblok::field_key("article", "")
+					}
+				`,
+				ExpectError: regexp.MustCompile(`the field key must be set and can.t\s+contain a dot`),
+			},
+			{
+				Config: testFunctionsProviders + `
+					locals {
+					  parsed = provider::storyblok::parse_id("233252/1001")
+					}
+
+					output "space_id" {
+					  value = local.parsed.space_id
+					}
+
+					output "id" {
+					  value = local.parsed.id
+					}
+
+					output "field_key" {
+					  value = provider::storyblok::field_key("article", "title")
+					}
+
+					output "region_url" {
+					  value = provider::storyblok::region_url(1000123)
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("space_id", "233252"),
+					resource.TestCheckOutput("id", "1001"),
+					resource.TestCheckOutput("field_key", "article.title"),
+					resource.TestCheckOutput("region_url", "https://api-us.storyblok.com"),
+				),
+			},
+		},
+	})
+}
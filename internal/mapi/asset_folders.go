@@ -0,0 +1,50 @@
+package mapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// AssetFolder defines a folder in the asset library of a space.
+type AssetFolder struct {
+	Id       int64  `json:"id"`
+	Name     string `json:"name"`
+	ParentId *int64 `json:"parent_id"`
+}
+
+// AssetFolderBase contains the writable fields of an asset folder. Unlike the
+// sdk it sends an empty parent_id, which moves the folder to the root.
+type AssetFolderBase struct {
+	Name     string `json:"name"`
+	ParentId *int64 `json:"parent_id"`
+}
+
+type AssetFolderInput struct {
+	AssetFolder AssetFolderBase `json:"asset_folder"`
+}
+
+type AssetFolderResponse struct {
+	AssetFolder AssetFolder `json:"asset_folder"`
+}
+
+type AssetFoldersResponse struct {
+	AssetFolders []AssetFolder `json:"asset_folders"`
+}
+
+func (c *Client) ListAssetFolders(ctx context.Context, spaceID int64) (*Response[AssetFoldersResponse], error) {
+	return do[AssetFoldersResponse](ctx, c, http.MethodGet, spacePath(spaceID, "/asset_folders"), nil)
+}
+
+func (c *Client) CreateAssetFolder(ctx context.Context, spaceID int64, input AssetFolderInput) (*Response[AssetFolderResponse], error) {
+	return do[AssetFolderResponse](ctx, c, http.MethodPost, spacePath(spaceID, "/asset_folders"), input)
+}
+
+// UpdateAssetFolder updates the asset folder. The API responds without a body.
+func (c *Client) UpdateAssetFolder(ctx context.Context, spaceID int64, id int64, input AssetFolderInput) (*Response[AssetFolderResponse], error) {
+	return do[AssetFolderResponse](ctx, c, http.MethodPut, spacePath(spaceID, "/asset_folders/%d", id), input)
+}
+
+// DeleteAssetFolder deletes the asset folder. The API responds without a body.
+func (c *Client) DeleteAssetFolder(ctx context.Context, spaceID int64, id int64) (*Response[AssetFolderResponse], error) {
+	return do[AssetFolderResponse](ctx, c, http.MethodDelete, spacePath(spaceID, "/asset_folders/%d", id), nil)
+}
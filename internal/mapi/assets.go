@@ -0,0 +1,168 @@
+package mapi
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+// Asset defines a file in the asset library of a space.
+type Asset struct {
+	Id            int64   `json:"id"`
+	Filename      string  `json:"filename"`
+	ShortFilename string  `json:"short_filename"`
+	ContentType   string  `json:"content_type"`
+	ContentLength int64   `json:"content_length"`
+	AssetFolderId *int64  `json:"asset_folder_id"`
+	Alt           *string `json:"alt"`
+	Title         *string `json:"title"`
+	Copyright     *string `json:"copyright"`
+	Focus         *string `json:"focus"`
+}
+
+// AssetBase contains the writable metadata of an asset.
+type AssetBase struct {
+	AssetFolderId *int64  `json:"asset_folder_id"`
+	Alt           *string `json:"alt"`
+	Title         *string `json:"title"`
+	Copyright     *string `json:"copyright"`
+	Focus         *string `json:"focus"`
+}
+
+type AssetInput struct {
+	Asset AssetBase `json:"asset"`
+}
+
+type AssetsResponse struct {
+	Assets []Asset `json:"assets"`
+}
+
+// ListAssetsParams filters the assets to list. Use a FolderId of -1 to list
+// the assets in the root folder.
+type ListAssetsParams struct {
+	FolderId int64
+	Page     int
+	PerPage  int
+}
+
+// assetMoveInput only contains the folder, so the other metadata of the
+// asset stays untouched.
+type assetMoveInput struct {
+	Asset struct {
+		AssetFolderId *int64 `json:"asset_folder_id"`
+	} `json:"asset"`
+}
+
+// SignedUploadInput requests a signed upload. When Id is set the file of the
+// existing asset is replaced.
+type SignedUploadInput struct {
+	Id             *int64 `json:"id,omitempty"`
+	Filename       string `json:"filename"`
+	Size           string `json:"size,omitempty"`
+	AssetFolderId  *int64 `json:"asset_folder_id,omitempty"`
+	ValidateUpload int    `json:"validate_upload"`
+}
+
+// SignedUpload contains the location and form fields to upload the file to.
+type SignedUpload struct {
+	Id        int64             `json:"id"`
+	PrettyUrl string            `json:"pretty_url"`
+	PostUrl   string            `json:"post_url"`
+	Fields    map[string]string `json:"fields"`
+}
+
+// CreateSignedUpload is the first step of uploading an asset. The returned
+// form fields must be posted together with the file using UploadAsset, after
+// which FinishAssetUpload completes the upload.
+func (c *Client) CreateSignedUpload(ctx context.Context, spaceID int64, input SignedUploadInput) (*Response[SignedUpload], error) {
+	return do[SignedUpload](ctx, c, http.MethodPost, spacePath(spaceID, "/assets"), input)
+}
+
+// UploadAsset posts the file to the location of the signed upload. The
+// request is not sent to the Management API and is therefore not
+// authenticated with the token.
+func (c *Client) UploadAsset(ctx context.Context, upload *SignedUpload, filename string, content []byte) (*Response[struct{}], error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	keys := make([]string, 0, len(upload.Fields))
+	for key := range upload.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := w.WriteField(key, upload.Fields[key]); err != nil {
+			return nil, err
+		}
+	}
+	part, err := w.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, upload.PostUrl, &buf)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	rsp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rsp.Body.Close() }()
+
+	body, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &Response[struct{}]{Body: body, HTTPResponse: rsp}, nil
+}
+
+func (c *Client) FinishAssetUpload(ctx context.Context, spaceID int64, id int64) (*Response[Asset], error) {
+	return do[Asset](ctx, c, http.MethodGet, spacePath(spaceID, "/assets/%d/finish_upload", id), nil)
+}
+
+func (c *Client) ListAssets(ctx context.Context, spaceID int64, params ListAssetsParams) (*Response[AssetsResponse], error) {
+	query := url.Values{}
+	query.Set("in_folder", strconv.FormatInt(params.FolderId, 10))
+	if params.Page > 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PerPage > 0 {
+		query.Set("per_page", strconv.Itoa(params.PerPage))
+	}
+	return do[AssetsResponse](ctx, c, http.MethodGet, spacePath(spaceID, "/assets?%s", query.Encode()), nil)
+}
+
+func (c *Client) GetAsset(ctx context.Context, spaceID int64, id int64) (*Response[Asset], error) {
+	return do[Asset](ctx, c, http.MethodGet, spacePath(spaceID, "/assets/%d", id), nil)
+}
+
+// UpdateAsset updates the metadata of an asset. The API responds without a
+// body, use GetAsset to retrieve the updated asset.
+func (c *Client) UpdateAsset(ctx context.Context, spaceID int64, id int64, input AssetInput) (*Response[Asset], error) {
+	return do[Asset](ctx, c, http.MethodPut, spacePath(spaceID, "/assets/%d", id), input)
+}
+
+// MoveAsset moves the asset to the given folder, or to the root when folderID
+// is nil. The API responds without a body.
+func (c *Client) MoveAsset(ctx context.Context, spaceID int64, id int64, folderID *int64) (*Response[Asset], error) {
+	input := assetMoveInput{}
+	input.Asset.AssetFolderId = folderID
+	return do[Asset](ctx, c, http.MethodPut, spacePath(spaceID, "/assets/%d", id), input)
+}
+
+func (c *Client) DeleteAsset(ctx context.Context, spaceID int64, id int64) (*Response[Asset], error) {
+	return do[Asset](ctx, c, http.MethodDelete, spacePath(spaceID, "/assets/%d", id), nil)
+}
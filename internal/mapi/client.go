@@ -0,0 +1,114 @@
+// Package mapi implements the Storyblok Management API endpoints that are not
+// (yet) available in the storyblok-go-sdk. The responses mimic the generated
+// sdk responses so the error helpers in the utils package can be used for both.
+package mapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Client is a minimal Storyblok Management API client.
+type Client struct {
+	server     string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient creates a new Client for the given server, using the personal
+// access token for authentication.
+func NewClient(server string, token string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		server:     strings.TrimSuffix(server, "/"),
+		token:      token,
+		httpClient: httpClient,
+	}
+}
+
+// Response holds the raw response and, for successful requests, the decoded
+// JSON body.
+type Response[T any] struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON         *T
+}
+
+// StatusCode returns the HTTP status code of the response.
+func (r *Response[T]) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Total returns the total number of items of paginated list responses, or -1
+// when unknown.
+func (r *Response[T]) Total() int {
+	if r.HTTPResponse == nil {
+		return -1
+	}
+	total, err := strconv.Atoi(r.HTTPResponse.Header.Get("Total"))
+	if err != nil {
+		return -1
+	}
+	return total
+}
+
+func do[T any](ctx context.Context, c *Client, method string, path string, body any) (*Response[T], error) {
+	var reader io.Reader
+	if body != nil {
+		buf, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(buf)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.server+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	rsp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rsp.Body.Close() }()
+
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Response[T]{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	if rsp.StatusCode >= 200 && rsp.StatusCode < 300 && len(bytes.TrimSpace(bodyBytes)) > 0 {
+		var dest T
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, fmt.Errorf("unable to decode response: %w", err)
+		}
+		response.JSON = &dest
+	}
+
+	return response, nil
+}
+
+func spacePath(spaceID int64, format string, args ...any) string {
+	return fmt.Sprintf("/v1/spaces/%d", spaceID) + fmt.Sprintf(format, args...)
+}
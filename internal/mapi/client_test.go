@@ -0,0 +1,57 @@
+package mapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientCreateWorkflow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/spaces/123/workflows", r.URL.Path)
+		assert.Equal(t, "my-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"workflow":{"name":"Editorial","content_types":["page"]}}`, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"workflow": map[string]any{"id": 1, "name": "Editorial", "content_types": []string{"page"}},
+		})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL+"/", "my-token", server.Client())
+	resp, err := c.CreateWorkflow(context.Background(), 123, WorkflowInput{
+		Workflow: WorkflowBase{Name: "Editorial", ContentTypes: &[]string{"page"}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode())
+	require.NotNil(t, resp.JSON)
+	assert.Equal(t, int64(1), resp.JSON.Workflow.Id)
+}
+
+func TestClientErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"name":["has already been taken"]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "my-token", server.Client())
+	resp, err := c.GetWorkflow(context.Background(), 123, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode())
+	assert.Nil(t, resp.JSON)
+	assert.Equal(t, `{"name":["has already been taken"]}`, string(resp.Body))
+}
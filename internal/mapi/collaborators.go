@@ -0,0 +1,124 @@
+package mapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// Collaborator is a user or pending invitation with access to a space.
+type Collaborator struct {
+	Id           int64                   `json:"id"`
+	UserId       *int64                  `json:"user_id"`
+	Role         string                  `json:"role"`
+	SpaceRoleId  *int64                  `json:"space_role_id"`
+	SpaceRoleIds []int                   `json:"space_role_ids"`
+	Permissions  []string                `json:"permissions"`
+	SsoId        *string                 `json:"sso_id"`
+	User         *CollaboratorUser       `json:"user"`
+	Invitation   *CollaboratorInvitation `json:"invitation"`
+}
+
+type CollaboratorUser struct {
+	Id        int64  `json:"id"`
+	Userid    string `json:"userid"`
+	Firstname string `json:"firstname"`
+	Lastname  string `json:"lastname"`
+	SsoId     string `json:"sso_id"`
+}
+
+type CollaboratorInvitation struct {
+	Email string `json:"email"`
+}
+
+// Email returns the email address of the user, or of the invitation when the
+// invitation has not been accepted yet.
+func (c Collaborator) Email() string {
+	if c.User != nil && c.User.Userid != "" {
+		return c.User.Userid
+	}
+	if c.Invitation != nil {
+		return c.Invitation.Email
+	}
+	return ""
+}
+
+// SSOID returns the identity provider id of SSO users, or an empty string for
+// regular users.
+func (c Collaborator) SSOID() string {
+	if c.SsoId != nil && *c.SsoId != "" {
+		return *c.SsoId
+	}
+	if c.User != nil {
+		return c.User.SsoId
+	}
+	return ""
+}
+
+// IsPending returns whether the collaborator still has to accept the
+// invitation.
+func (c Collaborator) IsPending() bool {
+	return c.User == nil
+}
+
+// CollaboratorCreateInput is used to add a collaborator to a space. Storyblok
+// sends an invitation when the email address is not yet known.
+type CollaboratorCreateInput struct {
+	Email                      string    `json:"email"`
+	Role                       *string   `json:"role,omitempty"`
+	SpaceRoleIds               *[]int    `json:"space_role_ids,omitempty"`
+	Permissions                *[]string `json:"permissions,omitempty"`
+	AllowMultipleRolesCreation bool      `json:"allow_multiple_roles_creation"`
+}
+
+type CollaboratorBase struct {
+	Role                       *string   `json:"role,omitempty"`
+	SpaceRoleIds               *[]int    `json:"space_role_ids,omitempty"`
+	Permissions                *[]string `json:"permissions,omitempty"`
+	AllowMultipleRolesCreation bool      `json:"allow_multiple_roles_creation"`
+}
+
+type CollaboratorUpdateInput struct {
+	Collaborator CollaboratorBase `json:"collaborator"`
+}
+
+type CollaboratorResponse struct {
+	Collaborator Collaborator `json:"collaborator"`
+}
+
+type CollaboratorsResponse struct {
+	Collaborators []Collaborator `json:"collaborators"`
+}
+
+// FindCollaborator returns the collaborator with the given id. When it does not
+// exist (anymore) the collaborator with the given email address is returned,
+// since accepting an invitation results in a new collaborator.
+func (r CollaboratorsResponse) FindCollaborator(id int64, email string) *Collaborator {
+	for i := range r.Collaborators {
+		if r.Collaborators[i].Id == id {
+			return &r.Collaborators[i]
+		}
+	}
+	for i := range r.Collaborators {
+		if email != "" && strings.EqualFold(r.Collaborators[i].Email(), email) {
+			return &r.Collaborators[i]
+		}
+	}
+	return nil
+}
+
+func (c *Client) ListCollaborators(ctx context.Context, spaceID int64) (*Response[CollaboratorsResponse], error) {
+	return do[CollaboratorsResponse](ctx, c, http.MethodGet, spacePath(spaceID, "/collaborators"), nil)
+}
+
+func (c *Client) CreateCollaborator(ctx context.Context, spaceID int64, input CollaboratorCreateInput) (*Response[CollaboratorResponse], error) {
+	return do[CollaboratorResponse](ctx, c, http.MethodPost, spacePath(spaceID, "/collaborators"), input)
+}
+
+func (c *Client) UpdateCollaborator(ctx context.Context, spaceID int64, id int64, input CollaboratorUpdateInput) (*Response[CollaboratorResponse], error) {
+	return do[CollaboratorResponse](ctx, c, http.MethodPut, spacePath(spaceID, "/collaborators/%d", id), input)
+}
+
+func (c *Client) DeleteCollaborator(ctx context.Context, spaceID int64, id int64) (*Response[CollaboratorResponse], error) {
+	return do[CollaboratorResponse](ctx, c, http.MethodDelete, spacePath(spaceID, "/collaborators/%d", id), nil)
+}
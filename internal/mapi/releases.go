@@ -0,0 +1,54 @@
+package mapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// Release defines a scheduled bundle of content changes.
+type Release struct {
+	Id               int64   `json:"id"`
+	Uuid             string  `json:"uuid"`
+	Name             string  `json:"name"`
+	ReleaseAt        *string `json:"release_at"`
+	Timezone         *string `json:"timezone"`
+	Released         bool    `json:"released"`
+	BranchesToDeploy []int   `json:"branches_to_deploy"`
+	OwnerId          *int64  `json:"owner_id"`
+	UsersToNotifyIds []int   `json:"users_to_notify_ids"`
+}
+
+// ReleaseBase contains the writable fields of a release. ReleaseAt is sent as
+// null when it is not set, so a scheduled release date is cleared.
+type ReleaseBase struct {
+	Name             string  `json:"name"`
+	ReleaseAt        *string `json:"release_at"`
+	Timezone         *string `json:"timezone,omitempty"`
+	BranchesToDeploy *[]int  `json:"branches_to_deploy,omitempty"`
+	OwnerId          *int64  `json:"owner_id,omitempty"`
+	UsersToNotifyIds *[]int  `json:"users_to_notify_ids,omitempty"`
+}
+
+type ReleaseInput struct {
+	Release ReleaseBase `json:"release"`
+}
+
+type ReleaseResponse struct {
+	Release Release `json:"release"`
+}
+
+func (c *Client) CreateRelease(ctx context.Context, spaceID int64, input ReleaseInput) (*Response[ReleaseResponse], error) {
+	return do[ReleaseResponse](ctx, c, http.MethodPost, spacePath(spaceID, "/releases"), input)
+}
+
+func (c *Client) GetRelease(ctx context.Context, spaceID int64, id int64) (*Response[ReleaseResponse], error) {
+	return do[ReleaseResponse](ctx, c, http.MethodGet, spacePath(spaceID, "/releases/%d", id), nil)
+}
+
+func (c *Client) UpdateRelease(ctx context.Context, spaceID int64, id int64, input ReleaseInput) (*Response[ReleaseResponse], error) {
+	return do[ReleaseResponse](ctx, c, http.MethodPut, spacePath(spaceID, "/releases/%d", id), input)
+}
+
+func (c *Client) DeleteRelease(ctx context.Context, spaceID int64, id int64) (*Response[ReleaseResponse], error) {
+	return do[ReleaseResponse](ctx, c, http.MethodDelete, spacePath(spaceID, "/releases/%d", id), nil)
+}
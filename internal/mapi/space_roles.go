@@ -0,0 +1,22 @@
+package mapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// SpaceRole contains the fields of a space role needed to list them. The
+// sdk response for listing space roles does not match the API response.
+type SpaceRole struct {
+	Id    int64   `json:"id"`
+	Role  string  `json:"role"`
+	ExtId *string `json:"ext_id"`
+}
+
+type SpaceRolesResponse struct {
+	SpaceRoles []SpaceRole `json:"space_roles"`
+}
+
+func (c *Client) ListSpaceRoles(ctx context.Context, spaceID int64) (*Response[SpaceRolesResponse], error) {
+	return do[SpaceRolesResponse](ctx, c, http.MethodGet, spacePath(spaceID, "/space_roles"), nil)
+}
@@ -0,0 +1,74 @@
+package mapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Story contains the fields of a story needed to find and migrate the content
+// of components. The content is only returned when retrieving a single story.
+type Story struct {
+	Id                 int64          `json:"id"`
+	Name               string         `json:"name"`
+	Slug               string         `json:"slug"`
+	FullSlug           string         `json:"full_slug"`
+	IsFolder           bool           `json:"is_folder"`
+	Content            map[string]any `json:"content,omitempty"`
+	Published          bool           `json:"published"`
+	UnpublishedChanges bool           `json:"unpublished_changes"`
+}
+
+type StoryResponse struct {
+	Story Story `json:"story"`
+}
+
+type StoriesResponse struct {
+	Stories []Story `json:"stories"`
+}
+
+// StoryContentInput updates the content of a story. With Publish set to 1 the
+// story is published as well.
+type StoryContentInput struct {
+	Story struct {
+		Content map[string]any `json:"content"`
+	} `json:"story"`
+	Publish int `json:"publish,omitempty"`
+}
+
+// ListStoriesParams filters the stories to list.
+type ListStoriesParams struct {
+	// ContainComponent only lists stories which use the component, also when
+	// it is nested in other components.
+	ContainComponent string
+	// WithSlug only lists the story with the full slug.
+	WithSlug string
+	Page     int
+	PerPage  int
+}
+
+func (c *Client) ListStories(ctx context.Context, spaceID int64, params ListStoriesParams) (*Response[StoriesResponse], error) {
+	query := url.Values{}
+	if params.ContainComponent != "" {
+		query.Set("contain_component", params.ContainComponent)
+	}
+	if params.WithSlug != "" {
+		query.Set("with_slug", params.WithSlug)
+	}
+	if params.Page > 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PerPage > 0 {
+		query.Set("per_page", strconv.Itoa(params.PerPage))
+	}
+	return do[StoriesResponse](ctx, c, http.MethodGet, spacePath(spaceID, "/stories?%s", query.Encode()), nil)
+}
+
+func (c *Client) GetStory(ctx context.Context, spaceID int64, id int64) (*Response[StoryResponse], error) {
+	return do[StoryResponse](ctx, c, http.MethodGet, spacePath(spaceID, "/stories/%d", id), nil)
+}
+
+func (c *Client) UpdateStoryContent(ctx context.Context, spaceID int64, id int64, input StoryContentInput) (*Response[StoryResponse], error) {
+	return do[StoryResponse](ctx, c, http.MethodPut, spacePath(spaceID, "/stories/%d", id), input)
+}
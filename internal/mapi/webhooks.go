@@ -0,0 +1,38 @@
+package mapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// WebhookLog is a delivery of a webhook, with the response of the endpoint.
+type WebhookLog struct {
+	Id           int64  `json:"id"`
+	Action       string `json:"action"`
+	StatusCode   int64  `json:"status_code"`
+	ResponseBody string `json:"response_body"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type WebhookLogsResponse struct {
+	WebhookLogs []WebhookLog `json:"webhook_logs"`
+}
+
+type ListWebhookLogsParams struct {
+	Page    int
+	PerPage int
+}
+
+// ListWebhookLogs lists the deliveries of the webhook, the most recent first.
+func (c *Client) ListWebhookLogs(ctx context.Context, spaceID int64, webhookID int64, params ListWebhookLogsParams) (*Response[WebhookLogsResponse], error) {
+	query := url.Values{}
+	if params.Page > 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.PerPage > 0 {
+		query.Set("per_page", strconv.Itoa(params.PerPage))
+	}
+	return do[WebhookLogsResponse](ctx, c, http.MethodGet, spacePath(spaceID, "/webhook_endpoints/%d/logs?%s", webhookID, query.Encode()), nil)
+}
@@ -0,0 +1,107 @@
+package mapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// Workflow defines a workflow in a space.
+type Workflow struct {
+	Id           int64    `json:"id"`
+	Name         string   `json:"name"`
+	ContentTypes []string `json:"content_types"`
+	IsDefault    bool     `json:"is_default"`
+}
+
+// WorkflowBase contains the writable fields of a workflow.
+type WorkflowBase struct {
+	Name         string    `json:"name"`
+	ContentTypes *[]string `json:"content_types,omitempty"`
+}
+
+type WorkflowInput struct {
+	Workflow WorkflowBase `json:"workflow"`
+}
+
+type WorkflowResponse struct {
+	Workflow Workflow `json:"workflow"`
+}
+
+// WorkflowStage defines a stage of a workflow.
+type WorkflowStage struct {
+	Id                int64  `json:"id"`
+	WorkflowId        int64  `json:"workflow_id"`
+	Name              string `json:"name"`
+	Color             string `json:"color"`
+	Position          *int64 `json:"position"`
+	IsDefault         bool   `json:"is_default"`
+	AllowPublish      bool   `json:"allow_publish"`
+	AllowAllStages    bool   `json:"allow_all_stages"`
+	AllowAllUsers     bool   `json:"allow_all_users"`
+	AllowAdminPublish bool   `json:"allow_admin_publish"`
+	AllowAdminChange  bool   `json:"allow_admin_change"`
+	AllowEditorChange bool   `json:"allow_editor_change"`
+	AfterPublishId    *int64 `json:"after_publish_id"`
+	UserIds           []int  `json:"user_ids"`
+	SpaceRoleIds      []int  `json:"space_role_ids"`
+	WorkflowStageIds  []int  `json:"workflow_stage_ids"`
+}
+
+// WorkflowStageBase contains the writable fields of a workflow stage.
+type WorkflowStageBase struct {
+	WorkflowId        int64   `json:"workflow_id"`
+	Name              string  `json:"name"`
+	Color             *string `json:"color,omitempty"`
+	Position          *int64  `json:"position,omitempty"`
+	IsDefault         *bool   `json:"is_default,omitempty"`
+	AllowPublish      *bool   `json:"allow_publish,omitempty"`
+	AllowAllStages    *bool   `json:"allow_all_stages,omitempty"`
+	AllowAllUsers     *bool   `json:"allow_all_users,omitempty"`
+	AllowAdminPublish *bool   `json:"allow_admin_publish,omitempty"`
+	AllowAdminChange  *bool   `json:"allow_admin_change,omitempty"`
+	AllowEditorChange *bool   `json:"allow_editor_change,omitempty"`
+	AfterPublishId    *int64  `json:"after_publish_id,omitempty"`
+	UserIds           *[]int  `json:"user_ids,omitempty"`
+	SpaceRoleIds      *[]int  `json:"space_role_ids,omitempty"`
+	WorkflowStageIds  *[]int  `json:"workflow_stage_ids,omitempty"`
+}
+
+type WorkflowStageInput struct {
+	WorkflowStage WorkflowStageBase `json:"workflow_stage"`
+}
+
+type WorkflowStageResponse struct {
+	WorkflowStage WorkflowStage `json:"workflow_stage"`
+}
+
+func (c *Client) CreateWorkflow(ctx context.Context, spaceID int64, input WorkflowInput) (*Response[WorkflowResponse], error) {
+	return do[WorkflowResponse](ctx, c, http.MethodPost, spacePath(spaceID, "/workflows"), input)
+}
+
+func (c *Client) GetWorkflow(ctx context.Context, spaceID int64, id int64) (*Response[WorkflowResponse], error) {
+	return do[WorkflowResponse](ctx, c, http.MethodGet, spacePath(spaceID, "/workflows/%d", id), nil)
+}
+
+func (c *Client) UpdateWorkflow(ctx context.Context, spaceID int64, id int64, input WorkflowInput) (*Response[WorkflowResponse], error) {
+	return do[WorkflowResponse](ctx, c, http.MethodPut, spacePath(spaceID, "/workflows/%d", id), input)
+}
+
+func (c *Client) DeleteWorkflow(ctx context.Context, spaceID int64, id int64) (*Response[WorkflowResponse], error) {
+	return do[WorkflowResponse](ctx, c, http.MethodDelete, spacePath(spaceID, "/workflows/%d", id), nil)
+}
+
+func (c *Client) CreateWorkflowStage(ctx context.Context, spaceID int64, input WorkflowStageInput) (*Response[WorkflowStageResponse], error) {
+	return do[WorkflowStageResponse](ctx, c, http.MethodPost, spacePath(spaceID, "/workflow_stages"), input)
+}
+
+func (c *Client) GetWorkflowStage(ctx context.Context, spaceID int64, id int64) (*Response[WorkflowStageResponse], error) {
+	return do[WorkflowStageResponse](ctx, c, http.MethodGet, spacePath(spaceID, "/workflow_stages/%d", id), nil)
+}
+
+func (c *Client) UpdateWorkflowStage(ctx context.Context, spaceID int64, id int64, input WorkflowStageInput) (*Response[WorkflowStageResponse], error) {
+	return do[WorkflowStageResponse](ctx, c, http.MethodPut, spacePath(spaceID, "/workflow_stages/%d", id), input)
+}
+
+func (c *Client) DeleteWorkflowStage(ctx context.Context, spaceID int64, id int64) (*Response[WorkflowStageResponse], error) {
+	return do[WorkflowStageResponse](ctx, c, http.MethodDelete, spacePath(spaceID, "/workflow_stages/%d", id), nil)
+}
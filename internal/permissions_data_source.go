@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &permissionsDataSource{}
+)
+
+// NewPermissionsDataSource is a helper function to simplify the provider implementation.
+func NewPermissionsDataSource() datasource.DataSource {
+	return &permissionsDataSource{}
+}
+
+// permissionsDataSource is the data source implementation.
+type permissionsDataSource struct{}
+
+// permissionsDataSourceModel maps the data source schema data.
+type permissionsDataSourceModel struct {
+	ID          types.String      `tfsdk:"id"`
+	Permissions []permissionModel `tfsdk:"permissions"`
+	Names       []types.String    `tfsdk:"names"`
+}
+
+type permissionModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+// Metadata returns the data source type name.
+func (d *permissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+// Schema defines the schema for the data source.
+func (d *permissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the permissions which can be granted with the `permissions` of `storyblok_space_role`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the data source.",
+				Computed:    true,
+			},
+			"permissions": schema.ListNestedAttribute{
+				Description: "The permissions with their description, ordered by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The identifier of the permission.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "What the permission allows.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"names": schema.ListAttribute{
+				Description: "The identifiers of the permissions, ordered by name.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *permissionsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	permissions := getSpaceRolePermissions()
+
+	state := permissionsDataSourceModel{
+		ID:          types.StringValue("permissions"),
+		Permissions: []permissionModel{},
+		Names:       []types.String{},
+	}
+	for _, name := range utils.SortedKeys(permissions) {
+		state.Permissions = append(state.Permissions, permissionModel{
+			Name:        types.StringValue(name),
+			Description: types.StringValue(permissions[name]),
+		})
+		state.Names = append(state.Names, types.StringValue(name))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,47 @@
+package internal
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+func TestPermissionsDataSource(t *testing.T) {
+	f, stop := ProviderFactories("./assets/permissions")
+	defer func() {
+		_ = stop()
+	}()
+
+	dn := "data.storyblok_permissions.all"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config: utils.HCLTemplate(`
+					resource "storyblok_space_role" "editor" {
+					  space_id    = {{ .spaceId }}
+					  role        = "editor"
+					  permissions = ["publish_storie"]
+					}
+				`, map[string]any{"spaceId": 233252}),
+				ExpectError: regexp.MustCompile(`Attribute permissions\[0\] value must be one of`),
+			},
+			{
+				Config: `
+					data "storyblok_permissions" "all" {}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dn, "permissions.0.name", "access_commerce"),
+					resource.TestCheckResourceAttr(dn, "permissions.0.description", "Access the commerce app"),
+					resource.TestCheckTypeSetElemAttr(dn, "names.*", "publish_stories"),
+					resource.TestCheckTypeSetElemAttr(dn, "names.*", "access_tasks"),
+				),
+			},
+		},
+	})
+}
@@ -9,23 +9,34 @@ import (
 	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/labd/storyblok-go-sdk/sbmgmt"
+	"github.com/labd/terraform-provider-storyblok/internal/asset"
+	"github.com/labd/terraform-provider-storyblok/internal/assetfolder"
+	"github.com/labd/terraform-provider-storyblok/internal/collaborator"
 	"github.com/labd/terraform-provider-storyblok/internal/component"
+	"github.com/labd/terraform-provider-storyblok/internal/content"
+	"github.com/labd/terraform-provider-storyblok/internal/functions"
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/release"
+	"github.com/labd/terraform-provider-storyblok/internal/sso"
 	"github.com/labd/terraform-provider-storyblok/internal/utils"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 
 	"github.com/labd/terraform-provider-storyblok/internal/webhook"
+	"github.com/labd/terraform-provider-storyblok/internal/workflow"
 )
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider = &storyblokProvider{}
+	_ provider.Provider              = &storyblokProvider{}
+	_ provider.ProviderWithFunctions = &storyblokProvider{}
 )
 
 type OptionFunc func(p *storyblokProvider)
@@ -184,26 +195,54 @@ func (p *storyblokProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	data := &utils.ProviderData{
+		ClientWithResponsesInterface: client,
+		API:                          mapi.NewClient(url, token, p.httpClient),
+		Components:                   utils.NewComponentRegistry(),
+	}
+
 	// Make the Storyblok client available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = data
+	resp.ResourceData = data
 
 	tflog.Info(ctx, "Configured Storyblok client", map[string]any{"success": true})
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *storyblokProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		sso.NewSSOCollaboratorsDataSource,
+		component.NewComponentsExportDataSource,
+		NewPermissionsDataSource,
+		webhook.NewWebhookLogsDataSource,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *storyblokProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewParseIDFunction,
+		functions.NewFieldKeyFunction,
+		functions.NewRegionURLFunction,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *storyblokProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		component.NewComponentResource,
+		component.NewComponentSetResource,
 		NewComponentGroupResource,
 		NewSpaceRoleResource,
 		NewAssetFolderResource,
+		assetfolder.NewTreeResource,
+		asset.NewAssetResource,
 		webhook.NewWebhookResource,
+		workflow.NewWorkflowResource,
+		workflow.NewWorkflowStageResource,
+		release.NewReleaseResource,
+		collaborator.NewCollaboratorResource,
+		content.NewMigrationResource,
 	}
 }
@@ -0,0 +1,82 @@
+package release
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// releaseResourceModel maps the resource schema data.
+type releaseResourceModel struct {
+	ID               types.String  `tfsdk:"id"`
+	ReleaseID        types.Int64   `tfsdk:"release_id"`
+	UUID             types.String  `tfsdk:"uuid"`
+	SpaceID          types.Int64   `tfsdk:"space_id"`
+	Name             types.String  `tfsdk:"name"`
+	ReleaseAt        types.String  `tfsdk:"release_at"`
+	Timezone         types.String  `tfsdk:"timezone"`
+	BranchesToDeploy []types.Int64 `tfsdk:"branches_to_deploy"`
+	OwnerID          types.Int64   `tfsdk:"owner_id"`
+	UsersToNotifyIDs []types.Int64 `tfsdk:"users_to_notify_ids"`
+	Released         types.Bool    `tfsdk:"released"`
+}
+
+func (m *releaseResourceModel) toInput() mapi.ReleaseInput {
+	return mapi.ReleaseInput{
+		Release: mapi.ReleaseBase{
+			Name:             m.Name.ValueString(),
+			ReleaseAt:        m.ReleaseAt.ValueStringPointer(),
+			Timezone:         utils.KnownStringPointer(m.Timezone),
+			BranchesToDeploy: utils.ConvertToPointerIntSlice(m.BranchesToDeploy),
+			OwnerId:          utils.KnownInt64Pointer(m.OwnerID),
+			UsersToNotifyIds: utils.ConvertToPointerIntSlice(m.UsersToNotifyIDs),
+		},
+	}
+}
+
+func (m *releaseResourceModel) fromRemote(spaceID int64, r *mapi.Release) error {
+	if r == nil {
+		return fmt.Errorf("release is nil")
+	}
+	m.ID = types.StringValue(utils.CreateIdentifier(spaceID, r.Id))
+	m.ReleaseID = types.Int64Value(r.Id)
+	m.UUID = types.StringValue(r.Uuid)
+	m.SpaceID = types.Int64Value(spaceID)
+	m.Name = types.StringValue(r.Name)
+	m.ReleaseAt = releaseAtValue(m.ReleaseAt, r.ReleaseAt)
+	m.Timezone = utils.FromStringPointer(r.Timezone)
+	m.BranchesToDeploy = utils.RemoteInt64Slice(m.BranchesToDeploy, r.BranchesToDeploy)
+	m.OwnerID = types.Int64PointerValue(r.OwnerId)
+	m.UsersToNotifyIDs = utils.RemoteInt64Slice(m.UsersToNotifyIDs, r.UsersToNotifyIds)
+	m.Released = types.BoolValue(r.Released)
+	return nil
+}
+
+// releaseAtValue returns the remote release date, but keeps the current value
+// when both point to the same moment in time. Storyblok normalizes the
+// timestamp, so comparing the strings would result in a permanent diff.
+func releaseAtValue(current types.String, remote *string) types.String {
+	if remote == nil {
+		return types.StringNull()
+	}
+	if current.IsNull() || current.IsUnknown() {
+		return types.StringValue(*remote)
+	}
+
+	currentTime, err := time.Parse(time.RFC3339, current.ValueString())
+	if err != nil {
+		return types.StringValue(*remote)
+	}
+	remoteTime, err := time.Parse(time.RFC3339, *remote)
+	if err != nil {
+		return types.StringValue(*remote)
+	}
+	if currentTime.Equal(remoteTime) {
+		return current
+	}
+	return types.StringValue(*remote)
+}
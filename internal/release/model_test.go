@@ -0,0 +1,63 @@
+package release
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+)
+
+func TestReleaseResourceModel_FromRemote(t *testing.T) {
+	releaseAt := "2025-03-01T08:00:00.000Z"
+	timezone := "Europe/Amsterdam"
+
+	model := &releaseResourceModel{
+		ReleaseAt: types.StringValue("2025-03-01T09:00:00+01:00"),
+	}
+	err := model.fromRemote(123, &mapi.Release{
+		Id:               456,
+		Uuid:             "8c3d1b7e-3a5f-4f43-9a0e-5b8b5f2f6a11",
+		Name:             "Spring campaign",
+		ReleaseAt:        &releaseAt,
+		Timezone:         &timezone,
+		BranchesToDeploy: []int{},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "123/456", model.ID.ValueString())
+	assert.Equal(t, "2025-03-01T09:00:00+01:00", model.ReleaseAt.ValueString(), "same moment should keep the configured value")
+	assert.Equal(t, "Europe/Amsterdam", model.Timezone.ValueString())
+	assert.Nil(t, model.BranchesToDeploy)
+	assert.True(t, model.OwnerID.IsNull())
+}
+
+func TestReleaseResourceModel_ToInput(t *testing.T) {
+	model := &releaseResourceModel{
+		Name:      types.StringValue("Spring campaign"),
+		ReleaseAt: types.StringNull(),
+		Timezone:  types.StringNull(),
+	}
+
+	body, err := json.Marshal(model.toInput())
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"release_at":null`, "a removed release date should be cleared")
+	assert.NotContains(t, string(body), `"timezone"`)
+
+	model.ReleaseAt = types.StringValue("2025-03-01T09:00:00+01:00")
+	body, err = json.Marshal(model.toInput())
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"release_at":"2025-03-01T09:00:00+01:00"`)
+}
+
+func TestReleaseAtValue(t *testing.T) {
+	remote := "2025-03-08T08:00:00.000Z"
+
+	assert.Equal(t, types.StringValue(remote), releaseAtValue(types.StringValue("2025-03-01T09:00:00+01:00"), &remote))
+	assert.Equal(t, types.StringValue("2025-03-08T09:00:00+01:00"), releaseAtValue(types.StringValue("2025-03-08T09:00:00+01:00"), &remote))
+	assert.Equal(t, types.StringValue(remote), releaseAtValue(types.StringNull(), &remote))
+	assert.Equal(t, types.StringNull(), releaseAtValue(types.StringValue("2025-03-08T09:00:00+01:00"), nil))
+}
@@ -0,0 +1,270 @@
+package release
+
+import (
+	"context"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/labd/terraform-provider-storyblok/internal/customvalidators"
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &releaseResource{}
+	_ resource.ResourceWithConfigure   = &releaseResource{}
+	_ resource.ResourceWithImportState = &releaseResource{}
+)
+
+// NewReleaseResource is a helper function to simplify the provider implementation.
+func NewReleaseResource() resource.Resource {
+	return &releaseResource{}
+}
+
+// releaseResource is the resource implementation.
+type releaseResource struct {
+	client *mapi.Client
+}
+
+// Metadata returns the data source type name.
+func (r *releaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_release"
+}
+
+// Schema defines the schema for the data source.
+func (r *releaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Releases bundle content changes so they can be published together at a scheduled moment, " +
+			"optionally deploying them to multiple branches.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The terraform ID of the release. This is a composite ID, " +
+					"and should not be used as reference",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"release_id": schema.Int64Attribute{
+				Description: "The ID of the release.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Description: "The UUID of the release.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": schema.Int64Attribute{
+				Description: "The ID of the space.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the release.",
+				Required:    true,
+			},
+			"release_at": schema.StringAttribute{
+				Description: "The moment the release is published as RFC 3339 timestamp, for example " +
+					"`2025-03-01T09:00:00+01:00`. When not set the release has to be published manually.",
+				Optional: true,
+				Validators: []validator.String{
+					customvalidators.Timestamp(),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Description: "The IANA timezone used to display the release date in the interface, for example " +
+					"`Europe/Amsterdam`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					customvalidators.Timezone(),
+				},
+			},
+			"branches_to_deploy": schema.ListAttribute{
+				Description: "IDs of the branches the release is deployed to.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
+			"owner_id": schema.Int64Attribute{
+				Description: "The ID of the user owning the release. Defaults to the user of the access token.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"users_to_notify_ids": schema.ListAttribute{
+				Description: "IDs of the users that are notified when the release is published.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
+			"released": schema.BoolAttribute{
+				Description: "Whether the release has been published.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *releaseResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = utils.GetAPIClient(req.ProviderData)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *releaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan releaseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	input := plan.toInput()
+	spaceID := plan.SpaceID.ValueInt64()
+
+	content, err := r.client.CreateRelease(ctx, spaceID, input)
+	if d := utils.CheckCreateError("release", content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	release := &content.JSON.Release
+	tflog.Debug(ctx, spew.Sdump(release))
+
+	// Map response body to schema and populate Computed attribute values
+	if err := plan.fromRemote(spaceID, release); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating release",
+			"Could not create release, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *releaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state releaseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceId, id := utils.ParseIdentifier(state.ID.ValueString())
+
+	content, err := r.client.GetRelease(ctx, spaceId, id)
+	if d := utils.CheckGetError("release", id, content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	if err := state.fromRemote(spaceId, &content.JSON.Release); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Storyblok Release",
+			"Could not read Storyblok release ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *releaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan releaseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	input := plan.toInput()
+	spaceID := plan.SpaceID.ValueInt64()
+
+	content, err := r.client.UpdateRelease(ctx, spaceID, plan.ReleaseID.ValueInt64(), input)
+	if d := utils.CheckUpdateError("release", content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	release := &content.JSON.Release
+	tflog.Debug(ctx, spew.Sdump(release))
+
+	// Map response body to schema and populate Computed attribute values
+	if err := plan.fromRemote(spaceID, release); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating release",
+			"Could not update release, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *releaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state releaseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceId, releaseId := utils.ParseIdentifier(state.ID.ValueString())
+	content, err := r.client.DeleteRelease(ctx, spaceId, releaseId)
+	if d := utils.CheckDeleteError("release", content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+}
+
+func (r *releaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,68 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+func TestReleaseResourceBasic(t *testing.T) {
+	f, stop := ProviderFactories("./assets/release")
+	defer func() {
+		_ = stop()
+	}()
+
+	id := "test"
+	rn := fmt.Sprintf("storyblok_release.%s", id)
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config:      testReleaseConfig(id, spaceId, "Spring campaign", "2025-03-08T08:00:00Z", "Mars/Olympus"),
+				ExpectError: regexp.MustCompile("value must be a valid IANA timezone"),
+			},
+			{
+				Config: testReleaseConfig(id, spaceId, "Spring campaign", "2025-03-01T08:00:00Z", "Europe/Amsterdam"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "name", "Spring campaign"),
+					resource.TestCheckResourceAttr(rn, "release_at", "2025-03-01T08:00:00Z"),
+					resource.TestCheckResourceAttr(rn, "timezone", "Europe/Amsterdam"),
+					resource.TestCheckResourceAttr(rn, "branches_to_deploy.#", "1"),
+					resource.TestCheckResourceAttr(rn, "branches_to_deploy.0", "1234"),
+					resource.TestCheckResourceAttr(rn, "released", "false"),
+					resource.TestCheckResourceAttrSet(rn, "owner_id"),
+				),
+			},
+			{
+				Config: testReleaseConfig(id, spaceId, "Spring campaign", "2025-03-08T08:00:00Z", "Europe/Amsterdam"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "release_at", "2025-03-08T08:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func testReleaseConfig(identifier string, spaceId int, name string, releaseAt string, timezone string) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_release" "{{ .identifier }}" {
+		  space_id           = {{ .spaceId }}
+		  name               = "{{ .name }}"
+		  release_at         = "{{ .releaseAt }}"
+		  timezone           = "{{ .timezone }}"
+		  branches_to_deploy = [1234]
+		}
+	`, map[string]any{
+		"identifier": identifier,
+		"spaceId":    spaceId,
+		"name":       name,
+		"releaseAt":  releaseAt,
+		"timezone":   timezone,
+	})
+}
@@ -1,11 +1,19 @@
 package internal
 
 import (
+	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/labd/storyblok-go-sdk/sbmgmt"
 
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
 	"github.com/labd/terraform-provider-storyblok/internal/utils"
 )
 
@@ -24,9 +32,25 @@ type spaceRoleResourceModel struct {
 	FieldPermissions         []types.String `tfsdk:"field_permissions"`
 	Permissions              []types.String `tfsdk:"permissions"`
 	ReadonlyFieldPermissions []types.String `tfsdk:"readonly_field_permissions"`
-	ResolvedAllowedPaths     []types.String `tfsdk:"resolved_allowed_paths"`
+	ResolvedAllowedPaths     types.List     `tfsdk:"resolved_allowed_paths"`
 	Role                     types.String   `tfsdk:"role"`
 	Subtitle                 types.String   `tfsdk:"subtitle"`
+
+	FieldPermission []fieldPermissionModel `tfsdk:"field_permission"`
+}
+
+type fieldPermissionModel struct {
+	Component types.String `tfsdk:"component"`
+	Field     types.String `tfsdk:"field"`
+	Readonly  types.Bool   `tfsdk:"readonly"`
+}
+
+// fieldPermissionEntry is a field permission with the path of the attribute
+// or block it is configured with.
+type fieldPermissionEntry struct {
+	Component string
+	Field     string
+	Path      path.Path
 }
 
 func (m *spaceRoleResourceModel) toCreateInput() sbmgmt.SpaceRoleCreateInput {
@@ -38,10 +62,9 @@ func (m *spaceRoleResourceModel) toCreateInput() sbmgmt.SpaceRoleCreateInput {
 			ComponentIds:             utils.ConvertToPointerIntSlice(m.ComponentIds),
 			DatasourceIds:            utils.ConvertToPointerIntSlice(m.DatasourceIds),
 			ExtId:                    m.ExternalID.ValueStringPointer(),
-			FieldPermissions:         utils.ConvertToPointerStringSlice(m.FieldPermissions),
+			FieldPermissions:         m.fieldPermissions(false),
 			Permissions:              utils.ConvertToPointerStringSlice(m.Permissions),
-			ReadonlyFieldPermissions: utils.ConvertToPointerStringSlice(m.ReadonlyFieldPermissions),
-			ResolvedAllowedPaths:     utils.ConvertToPointerStringSlice(m.ResolvedAllowedPaths),
+			ReadonlyFieldPermissions: m.fieldPermissions(true),
 			Role:                     m.Role.ValueString(),
 			Subtitle:                 m.Subtitle.ValueStringPointer(),
 		},
@@ -56,10 +79,9 @@ func (m *spaceRoleResourceModel) toUpdateInput() sbmgmt.SpaceRoleUpdateInput {
 			ComponentIds:             utils.ConvertToPointerIntSlice(m.ComponentIds),
 			DatasourceIds:            utils.ConvertToPointerIntSlice(m.DatasourceIds),
 			ExtId:                    m.ExternalID.ValueStringPointer(),
-			FieldPermissions:         utils.ConvertToPointerStringSlice(m.FieldPermissions),
+			FieldPermissions:         m.fieldPermissions(false),
 			Permissions:              utils.ConvertToPointerStringSlice(m.Permissions),
-			ReadonlyFieldPermissions: utils.ConvertToPointerStringSlice(m.ReadonlyFieldPermissions),
-			ResolvedAllowedPaths:     utils.ConvertToPointerStringSlice(m.ResolvedAllowedPaths),
+			ReadonlyFieldPermissions: m.fieldPermissions(true),
 			Role:                     m.Role.ValueString(),
 			Subtitle:                 m.Subtitle.ValueStringPointer(),
 		},
@@ -74,3 +96,175 @@ func (m *spaceRoleResourceModel) fromRemote(spaceId int64, c *sbmgmt.SpaceRole)
 	m.RoleID = types.Int64Value(int64(c.Id))
 	return nil
 }
+
+// isStoryID returns whether the allowed path is the id of a story rather than
+// a full slug.
+func isStoryID(value string) bool {
+	_, err := strconv.ParseInt(value, 10, 64)
+	return err == nil
+}
+
+// resolveAllowedPaths returns the allowed paths as story ids, looking up the
+// stories of the paths which are configured as full slug, and the full slugs
+// of the allowed paths. Story ids are sent as they are, so a story which
+// can't be read only results in a warning and is left out of the full slugs.
+func resolveAllowedPaths(ctx context.Context, api *mapi.Client, spaceID int64, paths []string) ([]string, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	ids := make([]string, 0, len(paths))
+	slugs := make([]string, 0, len(paths))
+
+	for i, value := range paths {
+		if isStoryID(value) {
+			ids = append(ids, value)
+
+			id, _ := strconv.ParseInt(value, 10, 64)
+			content, err := api.GetStory(ctx, spaceID, id)
+			if d := utils.CheckGetError("story", id, content, err); d != nil {
+				diags.AddWarning("Unable to resolve allowed path",
+					fmt.Sprintf("The full slug of allowed path %s is unknown: %s", value, d.Detail()))
+				continue
+			}
+			slugs = append(slugs, allowedPathSlug(content.JSON.Story))
+			continue
+		}
+
+		slug := strings.Trim(value, "/")
+		content, err := api.ListStories(ctx, spaceID, mapi.ListStoriesParams{WithSlug: slug})
+		if d := utils.CheckGetError("stories of space", spaceID, content, err); d != nil {
+			diags.Append(d)
+			return nil, nil, diags
+		}
+
+		story, ok := findStoryBySlug(content.JSON.Stories, slug)
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("allowed_paths").AtListIndex(i),
+				"Unknown allowed path",
+				fmt.Sprintf("No story or folder with the full slug %s exists in space %d.", value, spaceID),
+			)
+			return nil, nil, diags
+		}
+		ids = append(ids, strconv.FormatInt(story.Id, 10))
+		slugs = append(slugs, allowedPathSlug(story))
+	}
+	return ids, slugs, diags
+}
+
+// findStoryBySlug returns the story with the full slug, ignoring leading and
+// trailing slashes.
+func findStoryBySlug(stories []mapi.Story, slug string) (mapi.Story, bool) {
+	for _, story := range stories {
+		if strings.Trim(story.FullSlug, "/") == slug {
+			return story, true
+		}
+	}
+	return mapi.Story{}, false
+}
+
+// allowedPathSlug returns the full slug of the story as allowed path, with a
+// trailing slash for folders.
+func allowedPathSlug(story mapi.Story) string {
+	if story.IsFolder && !strings.HasSuffix(story.FullSlug, "/") {
+		return story.FullSlug + "/"
+	}
+	return story.FullSlug
+}
+
+// setResolvedAllowedPaths sets the full slugs of the allowed paths.
+func (m *spaceRoleResourceModel) setResolvedAllowedPaths(slugs []string) {
+	elements := make([]attr.Value, len(slugs))
+	for i, slug := range slugs {
+		elements[i] = types.StringValue(slug)
+	}
+	m.ResolvedAllowedPaths = types.ListValueMust(types.StringType, elements)
+}
+
+// fieldPermissions returns the hidden or the read only field permissions, from
+// the list of strings combined with the field_permission blocks.
+func (m *spaceRoleResourceModel) fieldPermissions(readonly bool) *[]string {
+	permissions := m.FieldPermissions
+	if readonly {
+		permissions = m.ReadonlyFieldPermissions
+	}
+	result := utils.ConvertToPointerStringSlice(permissions)
+
+	for _, permission := range m.FieldPermission {
+		if permission.Readonly.ValueBool() != readonly {
+			continue
+		}
+		key := permission.Component.ValueString() + "." + permission.Field.ValueString()
+		if result == nil {
+			result = &[]string{}
+		}
+		if !slices.Contains(*result, key) {
+			*result = append(*result, key)
+		}
+	}
+	return result
+}
+
+// fieldPermissionEntries returns the configured field permissions which are
+// known, both hidden and read only.
+func (m *spaceRoleResourceModel) fieldPermissionEntries() []fieldPermissionEntry {
+	var result []fieldPermissionEntry
+	lists := []struct {
+		name        string
+		permissions []types.String
+	}{
+		{"field_permissions", m.FieldPermissions},
+		{"readonly_field_permissions", m.ReadonlyFieldPermissions},
+	}
+	for _, list := range lists {
+		for i, permission := range list.permissions {
+			if permission.IsNull() || permission.IsUnknown() {
+				continue
+			}
+			component, field, ok := strings.Cut(permission.ValueString(), ".")
+			if !ok {
+				continue
+			}
+			result = append(result, fieldPermissionEntry{
+				Component: component,
+				Field:     field,
+				Path:      path.Root(list.name).AtListIndex(i),
+			})
+		}
+	}
+	for i, permission := range m.FieldPermission {
+		if permission.Component.IsUnknown() || permission.Field.IsUnknown() {
+			continue
+		}
+		result = append(result, fieldPermissionEntry{
+			Component: permission.Component.ValueString(),
+			Field:     permission.Field.ValueString(),
+			Path:      path.Root("field_permission").AtListIndex(i),
+		})
+	}
+	return result
+}
+
+// getSpaceRolePermissions returns the permissions which can be granted to a
+// space role, with their description.
+func getSpaceRolePermissions() map[string]string {
+	return map[string]string{
+		"access_commerce":         "Access the commerce app",
+		"access_tasks":            "Access the tasks",
+		"change_alternate_group":  "Change the alternate group of stories",
+		"delete_stories":          "Delete stories and folders",
+		"deploy_stories":          "Deploy stories to the next stage of the pipeline",
+		"edit_datasource_entries": "Edit the entries of datasources",
+		"edit_datasources":        "Create and edit datasources",
+		"edit_image":              "Edit images in the image editor",
+		"edit_story_slug":         "Change the slug of stories",
+		"hide_datasources":        "Hide the datasources",
+		"manage_tags":             "Create and edit tags",
+		"move_story":              "Move stories to other folders",
+		"publish_folders":         "Publish folders",
+		"publish_stories":         "Publish stories",
+		"read_only":               "Read content without changing it",
+		"save_stories":            "Save stories",
+		"unpublish_stories":       "Unpublish stories",
+		"view_composer":           "Use the visual editor",
+		"view_content":            "View the content section",
+	}
+}
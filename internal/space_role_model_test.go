@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+func TestSpaceRoleResourceModel_FieldPermissions(t *testing.T) {
+	model := &spaceRoleResourceModel{
+		FieldPermissions: []types.String{types.StringValue("article.seo_title")},
+		FieldPermission: []fieldPermissionModel{
+			{Component: types.StringValue("article"), Field: types.StringValue("seo_title")},
+			{Component: types.StringValue("article"), Field: types.StringValue("author"), Readonly: types.BoolValue(false)},
+			{Component: types.StringValue("page"), Field: types.StringValue("slug"), Readonly: types.BoolValue(true)},
+		},
+	}
+
+	assert.Equal(t, &[]string{"article.seo_title", "article.author"}, model.fieldPermissions(false))
+	assert.Equal(t, &[]string{"page.slug"}, model.fieldPermissions(true))
+	assert.Nil(t, (&spaceRoleResourceModel{}).fieldPermissions(true))
+}
+
+func TestCheckFieldPermissions(t *testing.T) {
+	registry := utils.NewComponentRegistry()
+	require.NoError(t, registry.Load(1, func() (map[string]utils.RegisteredComponent, error) {
+		return map[string]utils.RegisteredComponent{
+			"article": {Fields: []string{"title", "headline"}},
+		}, nil
+	}))
+
+	model := &spaceRoleResourceModel{
+		FieldPermissions:         []types.String{types.StringValue("article.seo_title"), types.StringValue("article.headline")},
+		ReadonlyFieldPermissions: []types.String{types.StringValue("teaser.title"), types.StringUnknown()},
+		FieldPermission: []fieldPermissionModel{
+			{Component: types.StringValue("page"), Field: types.StringValue("slug")},
+		},
+	}
+
+	diags := checkFieldPermissions(1, model.fieldPermissionEntries(), registry)
+	require.Len(t, diags, 3)
+	assert.Equal(t, "Unknown field", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "component article has no field seo_title")
+	assert.Equal(t, "Unknown component", diags[1].Summary())
+	assert.Contains(t, diags[1].Detail(), "component teaser doesn't exist")
+	assert.Equal(t, "Unknown component", diags[2].Summary())
+	assert.Contains(t, diags[2].Detail(), "component page doesn't exist")
+	assert.Equal(t, 1, diags.ErrorsCount(), "a missing field on an existing component is an error")
+
+	paths := []path.Path{}
+	for _, entry := range model.fieldPermissionEntries() {
+		paths = append(paths, entry.Path)
+	}
+	assert.Equal(t, []path.Path{
+		path.Root("field_permissions").AtListIndex(0),
+		path.Root("field_permissions").AtListIndex(1),
+		path.Root("readonly_field_permissions").AtListIndex(0),
+		path.Root("field_permission").AtListIndex(0),
+	}, paths)
+}
+
+func TestFindStoryBySlug(t *testing.T) {
+	stories := []mapi.Story{
+		{Id: 501, FullSlug: "en/blog"},
+		{Id: 502, FullSlug: "en/blog/post"},
+	}
+
+	story, ok := findStoryBySlug(stories, "en/blog")
+	assert.True(t, ok)
+	assert.Equal(t, int64(501), story.Id)
+
+	_, ok = findStoryBySlug(stories, "en/news")
+	assert.False(t, ok)
+
+	assert.True(t, isStoryID("501"))
+	assert.False(t, isStoryID("en/blog/"))
+}
+
+func TestAllowedPathSlug(t *testing.T) {
+	assert.Equal(t, "en/blog/", allowedPathSlug(mapi.Story{FullSlug: "en/blog", IsFolder: true}))
+	assert.Equal(t, "en/blog/", allowedPathSlug(mapi.Story{FullSlug: "en/blog/", IsFolder: true}))
+	assert.Equal(t, "en/about", allowedPathSlug(mapi.Story{FullSlug: "en/about"}))
+}
@@ -2,17 +2,26 @@ package internal
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"slices"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/labd/storyblok-go-sdk/sbmgmt"
 
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
 	"github.com/labd/terraform-provider-storyblok/internal/utils"
 )
 
@@ -21,6 +30,7 @@ var (
 	_ resource.Resource                = &spaceRoleResource{}
 	_ resource.ResourceWithConfigure   = &spaceRoleResource{}
 	_ resource.ResourceWithImportState = &spaceRoleResource{}
+	_ resource.ResourceWithModifyPlan  = &spaceRoleResource{}
 )
 
 // NewSpaceRoleResource is a helper function to simplify the provider implementation.
@@ -30,7 +40,9 @@ func NewSpaceRoleResource() resource.Resource {
 
 // spaceRoleResource is the resource implementation.
 type spaceRoleResource struct {
-	client sbmgmt.ClientWithResponsesInterface
+	client     sbmgmt.ClientWithResponsesInterface
+	api        *mapi.Client
+	components *utils.ComponentRegistry
 }
 
 // Metadata returns the data source type name.
@@ -79,31 +91,47 @@ func (r *spaceRoleResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				ElementType: types.StringType,
 			},
 			"allowed_paths": schema.ListAttribute{
-				Description: "Story ids the user should have access to (acts as whitelist). If no item is selected the " +
-					"user has rights to access all content items.",
+				Description: "Stories and folders the user should have access to (acts as whitelist), either as story " +
+					"id or as full slug, like `en/blog/`. Full slugs are resolved to the story ids when applying. If " +
+					"no item is selected the user has rights to access all content items.",
 				Optional:    true,
 				ElementType: types.StringType,
 			},
 			"resolved_allowed_paths": schema.ListAttribute{
-				Description: "Resolved allowed_paths for displaying paths",
+				Description: "The full slugs of the `allowed_paths`, as looked up by the provider.",
 				Optional:    true,
+				Computed:    true,
+				DeprecationMessage: "resolved_allowed_paths is computed from allowed_paths, a configured value " +
+					"is ignored. Remove it from the configuration.",
 				ElementType: types.StringType,
 			},
 			"field_permissions": schema.ListAttribute{
 				Description: "Hide specific fields for this user with an array of strings with the schema: " +
-					"\"component_name.field_name\"",
+					"\"component_name.field_name\". A warning is shown for components which don't exist in the space when planning, " +
+					"and an error for fields which don't exist on a component in the space. Permissions for fields which are " +
+					"added or renamed in the same run must be added after the component is applied.",
 				Optional:    true,
 				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(fieldPermissionValidator),
+				},
 			},
 			"permissions": schema.ListAttribute{
-				Description: "Allow specific actions in interface by adding the permission as array of strings",
+				Description: "Allow specific actions in interface by adding the permission as array of strings. " +
+					"The `storyblok_permissions` data source lists the permissions with their description.",
 				Optional:    true,
 				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(utils.SortedKeys(getSpaceRolePermissions())...)),
+				},
 			},
 			"readonly_field_permissions": schema.ListAttribute{
-				Description: "Read only field permissions",
+				Description: "Read only field permissions, with the same schema and warnings as `field_permissions`",
 				Optional:    true,
 				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(fieldPermissionValidator),
+				},
 			},
 			"branch_ids": schema.ListAttribute{
 				Description: "Branch ids that the role is allowed access to",
@@ -121,9 +149,42 @@ func (r *spaceRoleResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				ElementType: types.Int64Type,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"field_permission": schema.ListNestedBlock{
+				Description: "A field which is hidden or read only for this role, as an alternative to " +
+					"`field_permissions` and `readonly_field_permissions`, with the same checks as " +
+					"`field_permissions`.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"component": schema.StringAttribute{
+							Description: "The technical name of the component.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^[^.]+$`), "must not contain a dot"),
+							},
+						},
+						"field": schema.StringAttribute{
+							Description: "The key of the field in the schema of the component.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^[^.]+$`), "must not contain a dot"),
+							},
+						},
+						"readonly": schema.BoolAttribute{
+							Description: "Make the field read only instead of hiding it.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
+// fieldPermissionValidator validates the schema of a field permission.
+var fieldPermissionValidator = stringvalidator.RegexMatches(
+	regexp.MustCompile(`^[^.]+\.[^.]+$`), "must have the schema component_name.field_name")
+
 // Configure adds the provider configured client to the data source.
 func (r *spaceRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -131,6 +192,113 @@ func (r *spaceRoleResource) Configure(_ context.Context, req resource.ConfigureR
 	}
 
 	r.client = utils.GetClient(req.ProviderData)
+	r.api = utils.GetAPIClient(req.ProviderData)
+	r.components = utils.GetComponentRegistry(req.ProviderData)
+}
+
+// ModifyPlan keeps the resolved allowed paths when the allowed paths are
+// unchanged, and checks the field permissions against the components in the
+// space, since permissions for unknown fields don't apply.
+func (r *spaceRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan spaceRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state spaceRoleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		var configured types.List
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("resolved_allowed_paths"), &configured)...)
+		if configured.IsNull() && slices.Equal(plan.AllowedPaths, state.AllowedPaths) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_allowed_paths"), state.ResolvedAllowedPaths)...)
+		}
+	}
+
+	if r.components == nil || plan.SpaceID.IsUnknown() {
+		return
+	}
+
+	entries := plan.fieldPermissionEntries()
+	if len(entries) == 0 {
+		return
+	}
+
+	spaceID := plan.SpaceID.ValueInt64()
+	if err := r.components.LoadRemote(ctx, r.client, spaceID); err != nil {
+		resp.Diagnostics.AddWarning("Unable to validate field permissions", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(checkFieldPermissions(spaceID, entries, r.components)...)
+}
+
+// checkFieldPermissions checks the field permissions against the components
+// which exist in the space. A missing component is a warning, as it may be
+// created in the same run. A missing field on an existing component is an
+// error, as a renamed field would no longer be hidden.
+func checkFieldPermissions(spaceID int64, entries []fieldPermissionEntry, components *utils.ComponentRegistry) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, entry := range entries {
+		component, exists := components.Get(spaceID, entry.Component)
+		switch {
+		case !exists:
+			diags.AddAttributeWarning(entry.Path, "Unknown component",
+				fmt.Sprintf("The field permission for %s.%s does not apply, component %s doesn't exist in the "+
+					"space. The warning can be ignored when the component is created in the same run.",
+					entry.Component, entry.Field, entry.Component))
+		case component.Fields != nil && !slices.Contains(component.Fields, entry.Field):
+			diags.AddAttributeError(entry.Path, "Unknown field",
+				fmt.Sprintf("The field permission for %s.%s does not apply, component %s has no field %s. "+
+					"The field may have been renamed. Permissions for fields which are added or renamed in the "+
+					"same run must be added after the component is applied.",
+					entry.Component, entry.Field, entry.Component, entry.Field))
+		}
+	}
+	return diags
+}
+
+// resolvedAllowedPathsConfiguredKey is the key of the private state which
+// marks that the deprecated resolved_allowed_paths is set in the
+// configuration, so it isn't overwritten when reading the space role.
+const resolvedAllowedPathsConfiguredKey = "resolved_allowed_paths_configured"
+
+// privateState is the private state of a resource.
+type privateState interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setResolvedAllowedPathsConfigured stores whether resolved_allowed_paths is
+// set in the configuration.
+func setResolvedAllowedPathsConfigured(ctx context.Context, config tfsdk.Config, private privateState) diag.Diagnostics {
+	var configured types.List
+	diags := config.GetAttribute(ctx, path.Root("resolved_allowed_paths"), &configured)
+	if configured.IsNull() {
+		diags.Append(private.SetKey(ctx, resolvedAllowedPathsConfiguredKey, nil)...)
+	} else {
+		diags.Append(private.SetKey(ctx, resolvedAllowedPathsConfiguredKey, []byte("true"))...)
+	}
+	return diags
+}
+
+// resolveAllowedPaths replaces the full slugs in the allowed paths with the
+// story ids, and returns the full slugs of the allowed paths.
+func (r *spaceRoleResource) resolveAllowedPaths(ctx context.Context, spaceID int64, allowedPaths *[]string) ([]string, diag.Diagnostics) {
+	if allowedPaths == nil {
+		return []string{}, nil
+	}
+	ids, slugs, diags := resolveAllowedPaths(ctx, r.api, spaceID, *allowedPaths)
+	if !diags.HasError() {
+		*allowedPaths = ids
+	}
+	return slugs, diags
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -147,6 +315,14 @@ func (r *spaceRoleResource) Create(ctx context.Context, req resource.CreateReque
 	input := plan.toCreateInput()
 	spaceID := plan.SpaceID.ValueInt64()
 
+	allowedPaths := utils.ConvertToPointerStringSlice(plan.AllowedPaths)
+	slugs, d := r.resolveAllowedPaths(ctx, spaceID, allowedPaths)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.SpaceRole.AllowedPaths = allowedPaths
+
 	content, err := r.client.CreateSpaceRoleWithResponse(ctx, spaceID, input)
 	if d := utils.CheckCreateError("space_role", content, err); d != nil {
 		resp.Diagnostics.Append(d)
@@ -164,6 +340,10 @@ func (r *spaceRoleResource) Create(ctx context.Context, req resource.CreateReque
 		)
 		return
 	}
+	if plan.ResolvedAllowedPaths.IsUnknown() {
+		plan.setResolvedAllowedPaths(slugs)
+	}
+	resp.Diagnostics.Append(setResolvedAllowedPathsConfigured(ctx, req.Config, resp.Private)...)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -202,6 +382,23 @@ func (r *spaceRoleResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// The full slugs are looked up again, as stories can be moved, unless
+	// they are set in the configuration
+	configured, d := req.Private.GetKey(ctx, resolvedAllowedPathsConfiguredKey)
+	resp.Diagnostics.Append(d...)
+	if len(configured) == 0 {
+		allowedPaths := spaceRole.AllowedPaths
+		if allowedPaths == nil {
+			allowedPaths = utils.ConvertToPointerStringSlice(state.AllowedPaths)
+		}
+		slugs, d := r.resolveAllowedPaths(ctx, spaceId, allowedPaths)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.setResolvedAllowedPaths(slugs)
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -224,6 +421,14 @@ func (r *spaceRoleResource) Update(ctx context.Context, req resource.UpdateReque
 	input := plan.toUpdateInput()
 	spaceID := plan.SpaceID.ValueInt64()
 
+	allowedPaths := utils.ConvertToPointerStringSlice(plan.AllowedPaths)
+	slugs, d := r.resolveAllowedPaths(ctx, spaceID, allowedPaths)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.SpaceRole.AllowedPaths = allowedPaths
+
 	content, err := r.client.UpdateSpaceRoleWithResponse(ctx, spaceID, plan.RoleID.ValueInt64(), input)
 	if d := utils.CheckUpdateError("space_role", content, err); d != nil {
 		resp.Diagnostics.Append(d)
@@ -241,6 +446,10 @@ func (r *spaceRoleResource) Update(ctx context.Context, req resource.UpdateReque
 		)
 		return
 	}
+	if plan.ResolvedAllowedPaths.IsUnknown() {
+		plan.setResolvedAllowedPaths(slugs)
+	}
+	resp.Diagnostics.Append(setResolvedAllowedPathsConfigured(ctx, req.Config, resp.Private)...)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
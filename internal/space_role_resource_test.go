@@ -2,6 +2,7 @@ package internal
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -85,3 +86,132 @@ func testSpaceRoleConfigUpdate(identifier string, spaceId int) string {
 		"spaceId":    spaceId,
 	})
 }
+
+func TestSpaceRoleResourceFieldPermissions(t *testing.T) {
+	f, stop := ProviderFactories("./assets/space_role_field_permissions")
+	defer func() {
+		_ = stop()
+	}()
+
+	rn := "storyblok_space_role.editor"
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config: utils.HCLTemplate(`
+					resource "storyblok_space_role" "editor" {
+					  space_id          = {{ .spaceId }}
+					  role              = "editor"
+					  field_permissions = ["article-seo_title"]
+					}
+				`, map[string]any{"spaceId": spaceId}),
+				ExpectError: regexp.MustCompile(`must have the schema\s+component_name.field_name`),
+			},
+			{
+				Config: testSpaceRoleFieldPermissionsConfig(spaceId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "field_permissions.#", "1"),
+					resource.TestCheckResourceAttr(rn, "field_permissions.0", "article.seo_title"),
+					resource.TestCheckResourceAttr(rn, "field_permission.#", "2"),
+					resource.TestCheckResourceAttr(rn, "field_permission.0.component", "article"),
+					resource.TestCheckResourceAttr(rn, "field_permission.0.field", "author"),
+					resource.TestCheckResourceAttr(rn, "field_permission.1.field", "slug"),
+					resource.TestCheckResourceAttr(rn, "field_permission.1.readonly", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testSpaceRoleFieldPermissionsConfig(spaceId int) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_component" "article" {
+		  space_id            = {{ .spaceId }}
+		  name                = "article"
+		  is_root             = true
+		  deletion_protection = false
+
+		  schema = {
+		    title     = { type = "text", position = 1 }
+		    seo_title = { type = "text", position = 2 }
+		    author    = { type = "text", position = 3 }
+		    slug      = { type = "text", position = 4 }
+		  }
+		}
+
+		resource "storyblok_space_role" "editor" {
+		  space_id          = {{ .spaceId }}
+		  role              = "editor"
+		  field_permissions = ["${storyblok_component.article.name}.seo_title"]
+
+		  field_permission {
+		    component = storyblok_component.article.name
+		    field     = "author"
+		  }
+
+		  field_permission {
+		    component = storyblok_component.article.name
+		    field     = "slug"
+		    readonly  = true
+		  }
+		}
+	`, map[string]any{"spaceId": spaceId})
+}
+
+func TestSpaceRoleResourceAllowedPaths(t *testing.T) {
+	f, stop := ProviderFactories("./assets/space_role_allowed_paths")
+	defer func() {
+		_ = stop()
+	}()
+
+	rn := "storyblok_space_role.blog"
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config:      testSpaceRoleAllowedPathsConfig(spaceId, "Blog", "en/news/"),
+				ExpectError: regexp.MustCompile(`No story or folder with the full slug en/news/ exists in\s+space`),
+			},
+			{
+				Config: testSpaceRoleAllowedPathsConfig(spaceId, "Blog", "en/blog/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "allowed_paths.#", "2"),
+					resource.TestCheckResourceAttr(rn, "allowed_paths.0", "en/blog/"),
+					resource.TestCheckResourceAttr(rn, "allowed_paths.1", "502"),
+					resource.TestCheckResourceAttr(rn, "resolved_allowed_paths.#", "2"),
+					resource.TestCheckResourceAttr(rn, "resolved_allowed_paths.0", "en/blog/"),
+					resource.TestCheckResourceAttr(rn, "resolved_allowed_paths.1", "en/about"),
+				),
+			},
+			{
+				Config: testSpaceRoleAllowedPathsConfig(spaceId, "Blog writers", "en/blog/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "subtitle", "Blog writers"),
+					resource.TestCheckResourceAttr(rn, "resolved_allowed_paths.#", "2"),
+					resource.TestCheckResourceAttr(rn, "resolved_allowed_paths.0", "en/blog/"),
+				),
+			},
+		},
+	})
+}
+
+func testSpaceRoleAllowedPathsConfig(spaceId int, subtitle string, slug string) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_space_role" "blog" {
+		  space_id      = {{ .spaceId }}
+		  role          = "blog"
+		  subtitle      = "{{ .subtitle }}"
+		  allowed_paths = ["{{ .slug }}", "502"]
+		}
+	`, map[string]any{
+		"spaceId":  spaceId,
+		"subtitle": subtitle,
+		"slug":     slug,
+	})
+}
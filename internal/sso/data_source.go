@@ -0,0 +1,208 @@
+package sso
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ssoCollaboratorsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ssoCollaboratorsDataSource{}
+)
+
+// NewSSOCollaboratorsDataSource is a helper function to simplify the provider implementation.
+func NewSSOCollaboratorsDataSource() datasource.DataSource {
+	return &ssoCollaboratorsDataSource{}
+}
+
+// ssoCollaboratorsDataSource is the data source implementation.
+type ssoCollaboratorsDataSource struct {
+	client *mapi.Client
+}
+
+// Metadata returns the data source type name.
+func (d *ssoCollaboratorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sso_collaborators"
+}
+
+// Schema defines the schema for the data source.
+func (d *ssoCollaboratorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists how identity provider groups map to space roles through the `external_id` of " +
+			"`storyblok_space_role`, and the collaborators that signed in through SSO.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the space as string.",
+				Computed:    true,
+			},
+			"space_id": schema.Int64Attribute{
+				Description: "The ID of the space.",
+				Required:    true,
+			},
+			"groups": schema.ListAttribute{
+				Description: "Names of the identity provider groups that are expected to map to a space role. " +
+					"Groups which do not map to exactly one space role are reported in `unmapped_groups` " +
+					"and `ambiguous_groups`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"strict": schema.BoolAttribute{
+				Description: "Fail instead of warn when a group in `groups` does not map to exactly one space role.",
+				Optional:    true,
+			},
+			"mappings": schema.ListNestedAttribute{
+				Description: "The identity provider groups with the space roles they are assigned, ordered by group name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"external_id": schema.StringAttribute{
+							Description: "The name of the identity provider group.",
+							Computed:    true,
+						},
+						"space_role_ids": schema.ListAttribute{
+							Description: "IDs of the space roles with this external id.",
+							Computed:    true,
+							ElementType: types.Int64Type,
+						},
+						"roles": schema.ListAttribute{
+							Description: "Names of the space roles with this external id.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"unmapped_groups": schema.ListAttribute{
+				Description: "Groups from `groups` without a space role.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"ambiguous_groups": schema.ListAttribute{
+				Description: "Groups that are the external id of more than one space role.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The collaborators of the space that signed in through SSO.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"collaborator_id": schema.Int64Attribute{
+							Description: "The ID of the collaborator.",
+							Computed:    true,
+						},
+						"user_id": schema.Int64Attribute{
+							Description: "The ID of the user.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the user.",
+							Computed:    true,
+						},
+						"sso_id": schema.StringAttribute{
+							Description: "The ID of the user in the identity provider.",
+							Computed:    true,
+						},
+						"space_role_ids": schema.ListAttribute{
+							Description: "IDs of the space roles assigned to the user.",
+							Computed:    true,
+							ElementType: types.Int64Type,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ssoCollaboratorsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = utils.GetAPIClient(req.ProviderData)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ssoCollaboratorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ssoCollaboratorsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := state.SpaceID.ValueInt64()
+
+	roles, err := d.client.ListSpaceRoles(ctx, spaceID)
+	if diag := utils.CheckGetError("space roles of space", spaceID, roles, err); diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+	tflog.Debug(ctx, spew.Sdump(roles.JSON.SpaceRoles))
+
+	collaborators, err := d.client.ListCollaborators(ctx, spaceID)
+	if diag := utils.CheckGetError("collaborators of space", spaceID, collaborators, err); diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprint(spaceID))
+	state.setMappings(roles.JSON.SpaceRoles)
+	state.setUsers(collaborators.JSON.Collaborators)
+
+	report := resp.Diagnostics.AddWarning
+	if state.Strict.ValueBool() {
+		report = resp.Diagnostics.AddError
+	}
+	if len(state.UnmappedGroups) > 0 {
+		report(
+			"Unmapped SSO groups",
+			"The following groups are not the external_id of any space role: "+joinValues(state.UnmappedGroups),
+		)
+	}
+	for _, group := range state.AmbiguousGroups {
+		if len(state.Groups) > 0 && !containsValue(state.Groups, group) {
+			continue
+		}
+		report(
+			"Ambiguous SSO group",
+			fmt.Sprintf("The group %q is the external_id of more than one space role", group.ValueString()),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func joinValues(values []types.String) string {
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = v.ValueString()
+	}
+	return strings.Join(result, ", ")
+}
+
+func containsValue(values []types.String, value types.String) bool {
+	for _, v := range values {
+		if v.Equal(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package sso
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+)
+
+// ssoCollaboratorsDataSourceModel maps the data source schema data.
+type ssoCollaboratorsDataSourceModel struct {
+	ID              types.String           `tfsdk:"id"`
+	SpaceID         types.Int64            `tfsdk:"space_id"`
+	Groups          []types.String         `tfsdk:"groups"`
+	Strict          types.Bool             `tfsdk:"strict"`
+	Mappings        []groupMappingModel    `tfsdk:"mappings"`
+	UnmappedGroups  []types.String         `tfsdk:"unmapped_groups"`
+	AmbiguousGroups []types.String         `tfsdk:"ambiguous_groups"`
+	Users           []ssoCollaboratorModel `tfsdk:"users"`
+}
+
+type groupMappingModel struct {
+	ExternalID   types.String   `tfsdk:"external_id"`
+	SpaceRoleIDs []types.Int64  `tfsdk:"space_role_ids"`
+	Roles        []types.String `tfsdk:"roles"`
+}
+
+type ssoCollaboratorModel struct {
+	CollaboratorID types.Int64   `tfsdk:"collaborator_id"`
+	UserID         types.Int64   `tfsdk:"user_id"`
+	Email          types.String  `tfsdk:"email"`
+	SsoID          types.String  `tfsdk:"sso_id"`
+	SpaceRoleIDs   []types.Int64 `tfsdk:"space_role_ids"`
+}
+
+// setMappings groups the space roles by their external id. Roles without an
+// external id cannot be assigned through SSO and are skipped.
+func (m *ssoCollaboratorsDataSourceModel) setMappings(roles []mapi.SpaceRole) {
+	byGroup := map[string]*groupMappingModel{}
+	groups := []string{}
+	for _, role := range roles {
+		if role.ExtId == nil || *role.ExtId == "" {
+			continue
+		}
+
+		mapping, ok := byGroup[*role.ExtId]
+		if !ok {
+			mapping = &groupMappingModel{
+				ExternalID:   types.StringValue(*role.ExtId),
+				SpaceRoleIDs: []types.Int64{},
+				Roles:        []types.String{},
+			}
+			byGroup[*role.ExtId] = mapping
+			groups = append(groups, *role.ExtId)
+		}
+		mapping.SpaceRoleIDs = append(mapping.SpaceRoleIDs, types.Int64Value(role.Id))
+		mapping.Roles = append(mapping.Roles, types.StringValue(role.Role))
+	}
+	sort.Strings(groups)
+
+	m.Mappings = []groupMappingModel{}
+	m.AmbiguousGroups = []types.String{}
+	for _, group := range groups {
+		mapping := byGroup[group]
+		m.Mappings = append(m.Mappings, *mapping)
+		if len(mapping.SpaceRoleIDs) > 1 {
+			m.AmbiguousGroups = append(m.AmbiguousGroups, types.StringValue(group))
+		}
+	}
+
+	m.UnmappedGroups = []types.String{}
+	for _, group := range m.Groups {
+		if _, ok := byGroup[group.ValueString()]; !ok {
+			m.UnmappedGroups = append(m.UnmappedGroups, group)
+		}
+	}
+}
+
+// setUsers stores the collaborators which signed in through SSO.
+func (m *ssoCollaboratorsDataSourceModel) setUsers(collaborators []mapi.Collaborator) {
+	m.Users = []ssoCollaboratorModel{}
+	for _, c := range collaborators {
+		if c.SSOID() == "" {
+			continue
+		}
+
+		user := ssoCollaboratorModel{
+			CollaboratorID: types.Int64Value(c.Id),
+			UserID:         types.Int64PointerValue(c.UserId),
+			Email:          types.StringValue(c.Email()),
+			SsoID:          types.StringValue(c.SSOID()),
+			SpaceRoleIDs:   []types.Int64{},
+		}
+		for _, id := range c.SpaceRoleIds {
+			user.SpaceRoleIDs = append(user.SpaceRoleIDs, types.Int64Value(int64(id)))
+		}
+		if len(user.SpaceRoleIDs) == 0 && c.SpaceRoleId != nil {
+			user.SpaceRoleIDs = append(user.SpaceRoleIDs, types.Int64Value(*c.SpaceRoleId))
+		}
+		m.Users = append(m.Users, user)
+	}
+}
@@ -0,0 +1,79 @@
+package sso
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+)
+
+func TestSSOCollaboratorsDataSourceModel_SetMappings(t *testing.T) {
+	model := &ssoCollaboratorsDataSourceModel{
+		Groups: []types.String{
+			types.StringValue("editors"),
+			types.StringValue("legal"),
+			types.StringValue("admins"),
+		},
+	}
+
+	model.setMappings([]mapi.SpaceRole{
+		{Id: 1, Role: "Legal", ExtId: ptr("legal")},
+		{Id: 2, Role: "Editor", ExtId: ptr("editors")},
+		{Id: 3, Role: "Legal reviewer", ExtId: ptr("legal")},
+		{Id: 4, Role: "Developer"},
+		{Id: 5, Role: "Translator", ExtId: ptr("")},
+	})
+
+	assert.Equal(t, []groupMappingModel{
+		{
+			ExternalID:   types.StringValue("editors"),
+			SpaceRoleIDs: []types.Int64{types.Int64Value(2)},
+			Roles:        []types.String{types.StringValue("Editor")},
+		},
+		{
+			ExternalID:   types.StringValue("legal"),
+			SpaceRoleIDs: []types.Int64{types.Int64Value(1), types.Int64Value(3)},
+			Roles:        []types.String{types.StringValue("Legal"), types.StringValue("Legal reviewer")},
+		},
+	}, model.Mappings)
+	assert.Equal(t, []types.String{types.StringValue("admins")}, model.UnmappedGroups)
+	assert.Equal(t, []types.String{types.StringValue("legal")}, model.AmbiguousGroups)
+}
+
+func TestSSOCollaboratorsDataSourceModel_SetUsers(t *testing.T) {
+	model := &ssoCollaboratorsDataSourceModel{}
+
+	model.setUsers([]mapi.Collaborator{
+		{
+			Id:           10,
+			UserId:       ptr(int64(20)),
+			SpaceRoleIds: []int{2},
+			User:         &mapi.CollaboratorUser{Id: 20, Userid: "jane@example.com", SsoId: "00u1abc"},
+		},
+		{
+			Id:     11,
+			UserId: ptr(int64(21)),
+			User:   &mapi.CollaboratorUser{Id: 21, Userid: "john@example.com"},
+		},
+		{
+			Id:         12,
+			Invitation: &mapi.CollaboratorInvitation{Email: "pending@example.com"},
+		},
+	})
+
+	assert.Equal(t, []ssoCollaboratorModel{
+		{
+			CollaboratorID: types.Int64Value(10),
+			UserID:         types.Int64Value(20),
+			Email:          types.StringValue("jane@example.com"),
+			SsoID:          types.StringValue("00u1abc"),
+			SpaceRoleIDs:   []types.Int64{types.Int64Value(2)},
+		},
+	}, model.Users)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,86 @@
+package internal
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+func TestSSOCollaboratorsDataSource(t *testing.T) {
+	f, stop := ProviderFactories("./assets/sso_collaborators")
+	defer func() {
+		_ = stop()
+	}()
+
+	spaceId := 233252
+	dn := "data.storyblok_sso_collaborators.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config:      testSSOCollaboratorsConfig(spaceId, true),
+				ExpectError: regexp.MustCompile("Ambiguous SSO group"),
+			},
+			{
+				Config: testSSOCollaboratorsConfig(spaceId, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dn, "mappings.#", "2"),
+					resource.TestCheckResourceAttr(dn, "mappings.0.external_id", "sb-editors"),
+					resource.TestCheckResourceAttrPair(
+						dn, "mappings.0.space_role_ids.0",
+						"storyblok_space_role.editor", "role_id"),
+					resource.TestCheckResourceAttr(dn, "mappings.1.external_id", "sb-legal"),
+					resource.TestCheckResourceAttr(dn, "mappings.1.space_role_ids.#", "2"),
+					resource.TestCheckResourceAttr(dn, "unmapped_groups.#", "1"),
+					resource.TestCheckResourceAttr(dn, "unmapped_groups.0", "sb-admins"),
+					resource.TestCheckResourceAttr(dn, "ambiguous_groups.#", "1"),
+					resource.TestCheckResourceAttr(dn, "ambiguous_groups.0", "sb-legal"),
+					resource.TestCheckResourceAttr(dn, "users.#", "1"),
+					resource.TestCheckResourceAttr(dn, "users.0.email", "jane@example.com"),
+					resource.TestCheckResourceAttr(dn, "users.0.sso_id", "00u1abc"),
+				),
+			},
+		},
+	})
+}
+
+func testSSOCollaboratorsConfig(spaceId int, strict bool) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_space_role" "editor" {
+		  space_id    = {{ .spaceId }}
+		  role        = "Editor"
+		  external_id = "sb-editors"
+		}
+
+		resource "storyblok_space_role" "legal" {
+		  space_id    = {{ .spaceId }}
+		  role        = "Legal"
+		  external_id = "sb-legal"
+
+		  depends_on = [storyblok_space_role.editor]
+		}
+
+		resource "storyblok_space_role" "legal_reviewer" {
+		  space_id    = {{ .spaceId }}
+		  role        = "Legal reviewer"
+		  external_id = "sb-legal"
+
+		  depends_on = [storyblok_space_role.legal]
+		}
+
+		data "storyblok_sso_collaborators" "test" {
+		  space_id = {{ .spaceId }}
+		  groups   = ["sb-editors", "sb-legal", "sb-admins"]
+		  strict   = {{ .strict }}
+
+		  depends_on = [storyblok_space_role.legal_reviewer]
+		}
+	`, map[string]any{
+		"spaceId": spaceId,
+		"strict":  strict,
+	})
+}
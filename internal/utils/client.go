@@ -1,6 +1,18 @@
 package utils
 
-import "github.com/labd/storyblok-go-sdk/sbmgmt"
+import (
+	"github.com/labd/storyblok-go-sdk/sbmgmt"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+)
+
+// ProviderData is passed to the resources and data sources. It embeds the sdk
+// client and adds a client for the endpoints which are not part of the sdk.
+type ProviderData struct {
+	sbmgmt.ClientWithResponsesInterface
+	API        *mapi.Client
+	Components *ComponentRegistry
+}
 
 func GetClient(data any) sbmgmt.ClientWithResponsesInterface {
 	c, ok := data.(sbmgmt.ClientWithResponsesInterface)
@@ -9,3 +21,11 @@ func GetClient(data any) sbmgmt.ClientWithResponsesInterface {
 	}
 	return c
 }
+
+func GetAPIClient(data any) *mapi.Client {
+	c, ok := data.(*ProviderData)
+	if !ok {
+		panic("invalid client type")
+	}
+	return c.API
+}
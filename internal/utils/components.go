@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/labd/storyblok-go-sdk/sbmgmt"
+)
+
+// RegisteredComponent is a component in the ComponentRegistry.
+type RegisteredComponent struct {
+	IsRoot bool
+	// Fields are the keys of the fields of the component.
+	Fields []string
+}
+
+// ComponentRegistry keeps the remote components of each space during a run of
+// the provider, to validate references between components without listing the
+// components of the space for every component. Only the components which
+// exist before the run are known, as components planned by other resources
+// depend on the order in which Terraform plans the resources.
+type ComponentRegistry struct {
+	mu     sync.Mutex
+	remote map[int64]map[string]RegisteredComponent
+}
+
+// NewComponentRegistry returns an empty registry.
+func NewComponentRegistry() *ComponentRegistry {
+	return &ComponentRegistry{
+		remote: map[int64]map[string]RegisteredComponent{},
+	}
+}
+
+// Load stores the remote components of the space by name. The components are
+// only loaded once per space.
+func (r *ComponentRegistry) Load(spaceID int64, load func() (map[string]RegisteredComponent, error)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.remote[spaceID]; ok {
+		return nil
+	}
+
+	components, err := load()
+	if err != nil {
+		return err
+	}
+	r.remote[spaceID] = components
+	return nil
+}
+
+// LoadRemote loads the remote components of the space with the client.
+func (r *ComponentRegistry) LoadRemote(ctx context.Context, client sbmgmt.ClientWithResponsesInterface, spaceID int64) error {
+	return r.Load(spaceID, func() (map[string]RegisteredComponent, error) {
+		content, err := client.ListComponentsWithResponse(ctx, spaceID)
+		if d := CheckGetError("components of space", spaceID, content, err); d != nil {
+			return nil, fmt.Errorf("%s", d.Detail())
+		}
+
+		components := map[string]RegisteredComponent{}
+		if content.JSON200.Components != nil {
+			for _, c := range *content.JSON200.Components {
+				component := RegisteredComponent{
+					IsRoot: c.IsRoot != nil && *c.IsRoot,
+					Fields: []string{},
+				}
+				if c.Schema != nil {
+					for pair := c.Schema.Oldest(); pair != nil; pair = pair.Next() {
+						component.Fields = append(component.Fields, pair.Key)
+					}
+				}
+				components[c.Name] = component
+			}
+		}
+		return components, nil
+	})
+}
+
+// Lookup returns whether the component exists in the space and whether it is
+// a root component.
+func (r *ComponentRegistry) Lookup(spaceID int64, name string) (exists bool, isRoot bool) {
+	component, exists := r.Get(spaceID, name)
+	return exists, component.IsRoot
+}
+
+// Get returns the remote component and whether it exists.
+func (r *ComponentRegistry) Get(spaceID int64, name string) (RegisteredComponent, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	component, exists := r.remote[spaceID][name]
+	return component, exists
+}
+
+// GetComponentRegistry returns the component registry of the provider.
+func GetComponentRegistry(data any) *ComponentRegistry {
+	c, ok := data.(*ProviderData)
+	if !ok {
+		panic("invalid client type")
+	}
+	return c.Components
+}
@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComponentRegistry(t *testing.T) {
+	registry := NewComponentRegistry()
+
+	loads := 0
+	load := func() (map[string]RegisteredComponent, error) {
+		loads++
+		return map[string]RegisteredComponent{
+			"page":       {IsRoot: true, Fields: []string{"title", "body"}},
+			"teaser":     {Fields: []string{"headline"}},
+			"old-banner": {},
+		}, nil
+	}
+	require.NoError(t, registry.Load(1, load))
+	require.NoError(t, registry.Load(1, load))
+	assert.Equal(t, 1, loads, "components should be loaded once per space")
+
+	for name, expected := range map[string][2]bool{
+		"page":       {true, true},
+		"teaser":     {true, false},
+		"old-banner": {true, false},
+		"missing":    {false, false},
+	} {
+		exists, isRoot := registry.Lookup(1, name)
+		assert.Equal(t, expected, [2]bool{exists, isRoot}, name)
+	}
+
+	exists, _ := registry.Lookup(2, "page")
+	assert.False(t, exists, "components of other spaces should not be found")
+
+	page, _ := registry.Get(1, "page")
+	assert.Equal(t, []string{"title", "body"}, page.Fields)
+}
+
+func TestComponentRegistryLoadError(t *testing.T) {
+	registry := NewComponentRegistry()
+
+	err := registry.Load(1, func() (map[string]RegisteredComponent, error) {
+		return nil, errors.New("unauthorized")
+	})
+	assert.EqualError(t, err, "unauthorized")
+
+	require.NoError(t, registry.Load(1, func() (map[string]RegisteredComponent, error) {
+		return map[string]RegisteredComponent{"page": {IsRoot: true}}, nil
+	}), "a failed load should be retried")
+	exists, _ := registry.Lookup(1, "page")
+	assert.True(t, exists)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"html/template"
 	"net/http"
+	"sort"
 	"strconv"
 
 	"github.com/elliotchance/pie/v2"
@@ -30,8 +31,13 @@ func SortComponentFields(input map[string]sbmgmt.FieldInput) *orderedmap.Ordered
 		})
 	}
 
+	// Fields with the same position are sorted by key, so the order of the
+	// json doesn't depend on the iteration order of the map
 	sorted := pie.SortUsing(values, func(a, b Pair) bool {
-		return a.Value.Pos < b.Value.Pos
+		if a.Value.Pos != b.Value.Pos {
+			return a.Value.Pos < b.Value.Pos
+		}
+		return a.Key < b.Key
 	})
 
 	result := orderedmap.New[string, sbmgmt.FieldInput]()
@@ -131,3 +137,30 @@ func contains(keep []string, key string) bool {
 	}
 	return false
 }
+
+// KnownStringPointer returns nil for null and unknown (computed) values.
+func KnownStringPointer(v types.String) *string {
+	if v.IsUnknown() {
+		return nil
+	}
+	return v.ValueStringPointer()
+}
+
+// KnownInt64Pointer returns nil for null and unknown (computed) values.
+func KnownInt64Pointer(v types.Int64) *int64 {
+	if v.IsUnknown() {
+		return nil
+	}
+	return v.ValueInt64Pointer()
+}
+
+// SortedKeys returns the keys of the map in sorted order, to produce
+// diagnostics and requests in a stable order.
+func SortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -1,9 +1,11 @@
 package utils
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/storyblok-go-sdk/sbmgmt"
 )
 
 func TestInterfacePointerToInt64_ValidInt64(t *testing.T) {
@@ -95,3 +97,23 @@ func TestInt64ToStringInterfacePointer_NullInput(t *testing.T) {
 		t.Errorf("expected nil result, got %v", *result)
 	}
 }
+
+func TestSortComponentFields(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		result := SortComponentFields(map[string]sbmgmt.FieldInput{
+			"title":  {Pos: 1},
+			"intro":  {Pos: 2},
+			"image":  {Pos: 2},
+			"body":   {Pos: 3},
+			"anchor": {Pos: 0},
+		})
+
+		keys := []string{}
+		for pair := result.Oldest(); pair != nil; pair = pair.Next() {
+			keys = append(keys, pair.Key)
+		}
+		if !reflect.DeepEqual(keys, []string{"anchor", "title", "image", "intro", "body"}) {
+			t.Fatalf("unexpected order: %v", keys)
+		}
+	}
+}
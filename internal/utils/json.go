@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// MergeJSON deep-merges src into dst. Objects are merged recursively, any
+// other value in src replaces the value in dst.
+func MergeJSON(dst, src map[string]any) map[string]any {
+	if dst == nil {
+		dst = make(map[string]any, len(src))
+	}
+	for key, value := range src {
+		srcObject, srcOk := value.(map[string]any)
+		dstObject, dstOk := dst[key].(map[string]any)
+		if srcOk && dstOk {
+			dst[key] = MergeJSON(dstObject, srcObject)
+			continue
+		}
+		dst[key] = value
+	}
+	return dst
+}
+
+// ProjectJSON returns the parts of remote that are set in set. Objects are
+// projected recursively, any other value is returned as is. Keys that are set
+// but are missing in remote are left out.
+func ProjectJSON(set, remote any) any {
+	setObject, setOk := set.(map[string]any)
+	remoteObject, remoteOk := remote.(map[string]any)
+	if !setOk || !remoteOk {
+		return remote
+	}
+
+	result := make(map[string]any, len(setObject))
+	for key, value := range setObject {
+		if remoteValue, ok := remoteObject[key]; ok {
+			result[key] = ProjectJSON(value, remoteValue)
+		}
+	}
+	return result
+}
+
+// EqualJSON returns whether two JSON documents are semantically equal.
+func EqualJSON(a, b string) bool {
+	var x, y any
+	if err := json.Unmarshal([]byte(a), &x); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &y); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
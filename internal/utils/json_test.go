@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeJSON(t *testing.T) {
+	dst := map[string]any{
+		"type":     "text",
+		"required": false,
+		"nested":   map[string]any{"a": 1.0, "b": 2.0},
+	}
+	src := map[string]any{
+		"required": true,
+		"new_flag": "value",
+		"nested":   map[string]any{"b": 3.0, "c": 4.0},
+	}
+
+	assert.Equal(t, map[string]any{
+		"type":     "text",
+		"required": true,
+		"new_flag": "value",
+		"nested":   map[string]any{"a": 1.0, "b": 3.0, "c": 4.0},
+	}, MergeJSON(dst, src))
+}
+
+func TestProjectJSON(t *testing.T) {
+	set := map[string]any{
+		"new_flag": "value",
+		"nested":   map[string]any{"b": 3.0},
+		"missing":  true,
+	}
+	remote := map[string]any{
+		"type":     "text",
+		"new_flag": "other",
+		"nested":   map[string]any{"a": 1.0, "b": 3.0},
+	}
+
+	assert.Equal(t, map[string]any{
+		"new_flag": "other",
+		"nested":   map[string]any{"b": 3.0},
+	}, ProjectJSON(set, remote))
+}
+
+func TestEqualJSON(t *testing.T) {
+	assert.True(t, EqualJSON(`{"a":1,"b":[true]}`, `{ "b": [true], "a": 1.0 }`))
+	assert.False(t, EqualJSON(`{"a":1}`, `{"a":2}`))
+	assert.False(t, EqualJSON(`{"a":1}`, `not json`))
+}
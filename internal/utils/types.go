@@ -54,3 +54,21 @@ func ConvertToPointerIntSlice(slice []types.Int64) *[]int {
 
 	return &result
 }
+
+// RemoteStringSlice returns the remote values, but keeps the current value
+// null when it was not set and the remote value is empty.
+func RemoteStringSlice(current []types.String, remote []string) []types.String {
+	if current == nil && len(remote) == 0 {
+		return nil
+	}
+	return ConvertToStringSlice(&remote)
+}
+
+// RemoteInt64Slice returns the remote values, but keeps the current value null
+// when it was not set and the remote value is empty.
+func RemoteInt64Slice(current []types.Int64, remote []int) []types.Int64 {
+	if current == nil && len(remote) == 0 {
+		return nil
+	}
+	return ConvertToInt64Slice(&remote)
+}
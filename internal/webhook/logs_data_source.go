@@ -0,0 +1,132 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// defaultLogsLimit is the number of deliveries which are returned when no
+// limit is set.
+const defaultLogsLimit = 25
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &webhookLogsDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhookLogsDataSource{}
+)
+
+// NewWebhookLogsDataSource is a helper function to simplify the provider implementation.
+func NewWebhookLogsDataSource() datasource.DataSource {
+	return &webhookLogsDataSource{}
+}
+
+// webhookLogsDataSource is the data source implementation.
+type webhookLogsDataSource struct {
+	api *mapi.Client
+}
+
+// Metadata returns the data source type name.
+func (d *webhookLogsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_logs"
+}
+
+// Schema defines the schema for the data source.
+func (d *webhookLogsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The recent deliveries of a webhook, the most recent first. Use the deliveries in a `check` " +
+			"block to be alerted when the endpoint of the webhook fails.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The terraform ID of the webhook. This is a composite ID, " +
+					"and should not be used as reference",
+				Computed: true,
+			},
+			"space_id": schema.Int64Attribute{
+				Description: "The ID of the space.",
+				Required:    true,
+			},
+			"webhook_id": schema.Int64Attribute{
+				Description: "The ID of the webhook, the `webhook_id` of the `storyblok_webhook` resource.",
+				Required:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of deliveries to return, 25 when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"deliveries": schema.ListNestedAttribute{
+				Description: "The recent deliveries of the webhook.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							Description: "When the delivery was sent.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "The action which triggered the delivery, like `story.published`.",
+							Computed:    true,
+						},
+						"status_code": schema.Int64Attribute{
+							Description: "The status code the endpoint responded with.",
+							Computed:    true,
+						},
+						"success": schema.BoolAttribute{
+							Description: "Whether the endpoint responded with a 2xx status code.",
+							Computed:    true,
+						},
+						"response_excerpt": schema.StringAttribute{
+							Description: "The first 256 characters of the response body of the endpoint.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *webhookLogsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.api = utils.GetAPIClient(req.ProviderData)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *webhookLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state webhookLogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := defaultLogsLimit
+	if !state.Limit.IsNull() {
+		limit = int(state.Limit.ValueInt64())
+	}
+
+	webhookID := state.WebhookID.ValueInt64()
+	content, err := d.api.ListWebhookLogs(ctx, state.SpaceID.ValueInt64(), webhookID, mapi.ListWebhookLogsParams{
+		PerPage: limit,
+	})
+	if d := utils.CheckGetError("webhook logs", webhookID, content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	state.fromRemote(content.JSON.WebhookLogs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// responseExcerptLength is the maximum number of characters of the response
+// body of a delivery.
+const responseExcerptLength = 256
+
+// webhookLogsDataSourceModel maps the data source schema data.
+type webhookLogsDataSourceModel struct {
+	ID         types.String           `tfsdk:"id"`
+	SpaceID    types.Int64            `tfsdk:"space_id"`
+	WebhookID  types.Int64            `tfsdk:"webhook_id"`
+	Limit      types.Int64            `tfsdk:"limit"`
+	Deliveries []webhookDeliveryModel `tfsdk:"deliveries"`
+}
+
+type webhookDeliveryModel struct {
+	Timestamp       types.String `tfsdk:"timestamp"`
+	Action          types.String `tfsdk:"action"`
+	StatusCode      types.Int64  `tfsdk:"status_code"`
+	Success         types.Bool   `tfsdk:"success"`
+	ResponseExcerpt types.String `tfsdk:"response_excerpt"`
+}
+
+func (m *webhookLogsDataSourceModel) fromRemote(logs []mapi.WebhookLog) {
+	m.ID = types.StringValue(utils.CreateIdentifier(m.SpaceID.ValueInt64(), m.WebhookID.ValueInt64()))
+	m.Deliveries = make([]webhookDeliveryModel, len(logs))
+	for i, log := range logs {
+		m.Deliveries[i] = webhookDeliveryModel{
+			Timestamp:       types.StringValue(log.CreatedAt),
+			Action:          types.StringValue(log.Action),
+			StatusCode:      types.Int64Value(log.StatusCode),
+			Success:         types.BoolValue(log.StatusCode >= 200 && log.StatusCode <= 299),
+			ResponseExcerpt: types.StringValue(excerpt(log.ResponseBody, responseExcerptLength)),
+		}
+	}
+}
+
+// excerpt returns the first characters of the value, marking it with an
+// ellipsis when it is shortened.
+func excerpt(value string, length int) string {
+	if utf8.RuneCountInString(value) <= length {
+		return value
+	}
+	return string([]rune(value)[:length]) + "…"
+}
@@ -0,0 +1,30 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+)
+
+func TestWebhookLogsDataSourceModel_FromRemote(t *testing.T) {
+	model := &webhookLogsDataSourceModel{
+		SpaceID:   types.Int64Value(233252),
+		WebhookID: types.Int64Value(4242),
+	}
+	model.fromRemote([]mapi.WebhookLog{
+		{Action: "story.published", StatusCode: 204, CreatedAt: "2026-10-19T08:00:00.000Z"},
+		{Action: "asset.deleted", StatusCode: 500, ResponseBody: strings.Repeat("é", 300)},
+	})
+
+	assert.Equal(t, "233252/4242", model.ID.ValueString())
+	require.Len(t, model.Deliveries, 2)
+	assert.True(t, model.Deliveries[0].Success.ValueBool())
+	assert.Equal(t, "", model.Deliveries[0].ResponseExcerpt.ValueString())
+	assert.False(t, model.Deliveries[1].Success.ValueBool())
+	assert.Equal(t, strings.Repeat("é", 256)+"…", model.Deliveries[1].ResponseExcerpt.ValueString())
+}
@@ -17,6 +17,8 @@ type WebhookModel struct {
 	Endpoint    types.String   `tfsdk:"endpoint"`
 	Activated   types.Bool     `tfsdk:"activated"`
 	Secret      types.String   `tfsdk:"secret"`
+
+	VerifyOnCreate types.Bool `tfsdk:"verify_on_create"`
 }
 
 func (m *WebhookModel) toCreateInput() sbmgmt.CreateWebhookJSONRequestBody {
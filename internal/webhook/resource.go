@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/labd/storyblok-go-sdk/sbmgmt"
@@ -82,10 +86,15 @@ func (r *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Default:     booldefault.StaticBool(true),
 				Computed:    true,
 			},
-			"actions": schema.ListAttribute{
-				Description: "The actions that should trigger the webhook.",
+			"actions": schema.SetAttribute{
+				Description: "The actions that should trigger the webhook, one of `" +
+					strings.Join(webhookActions, "`, `") + "`.",
 				Required:    true,
 				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(webhookActions...)),
+				},
 			},
 			"secret": schema.StringAttribute{
 				Description: "The secret to sign the webhook payload with.",
@@ -96,10 +105,38 @@ func (r *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "The description of the webhook.",
 				Optional:    true,
 			},
+			"verify_on_create": schema.BoolAttribute{
+				Description: "Send a test delivery to the endpoint before creating the webhook, and fail when the " +
+					"endpoint doesn't respond with a 2xx status code. The delivery has the action `test` and is " +
+					"signed with the `secret` in the `" + SignatureHeader + "` header, like the deliveries of " +
+					"Storyblok. The delivery is sent from the machine running Terraform, not by Storyblok, so an " +
+					"endpoint which is only reachable from a private network or VPN passes the check while " +
+					"Storyblok can't deliver to it.",
+				Optional: true,
+			},
 		},
 	}
 }
 
+// webhookActions are the actions in Storyblok which can trigger a webhook.
+var webhookActions = []string{
+	"asset.created",
+	"asset.deleted",
+	"asset.replaced",
+	"asset.restored",
+	"datasource.entries_updated",
+	"pipeline.deployed",
+	"release.merged",
+	"story.deleted",
+	"story.moved",
+	"story.published",
+	"story.unpublished",
+	"user.added",
+	"user.removed",
+	"user.roles_updated",
+	"workflow.stage.changed",
+}
+
 // Configure adds the provider configured client to the data source.
 func (r *webhookResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -119,6 +156,17 @@ func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	if plan.VerifyOnCreate.ValueBool() {
+		if err := verifyEndpoint(ctx, deliveryClient, &plan); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("endpoint"),
+				"Error verifying webhook endpoint",
+				"The test delivery to "+plan.Endpoint.ValueString()+" failed: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Generate API request body from plan
 	input := plan.toCreateInput()
 	spaceID := plan.SpaceID.ValueInt64()
@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// SignatureHeader is the header with the signature of a delivery, the hex
+// encoded HMAC-SHA1 of the body with the secret of the webhook.
+const SignatureHeader = "webhook-signature"
+
+// deliveryClient sends the test deliveries. The endpoint isn't part of the
+// Storyblok API, so the client of the provider isn't used.
+var deliveryClient = &http.Client{Timeout: 30 * time.Second}
+
+// testDelivery is the payload of a test delivery, which is shaped like the
+// payloads sent by Storyblok.
+type testDelivery struct {
+	Text    string `json:"text"`
+	Action  string `json:"action"`
+	SpaceID int64  `json:"space_id"`
+}
+
+// Sign returns the signature of the body with the secret, as sent in the
+// SignatureHeader.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// verifyEndpoint sends a test delivery to the endpoint of the webhook, signed
+// with the secret, and returns an error when the endpoint doesn't respond
+// with a 2xx status code. The delivery is sent by the provider, as Storyblok
+// has no endpoint to trigger a test delivery, so it doesn't show that
+// Storyblok can reach the endpoint.
+func verifyEndpoint(ctx context.Context, client *http.Client, m *WebhookModel) error {
+	body, err := json.Marshal(testDelivery{
+		Text:    fmt.Sprintf("Test delivery for webhook %s", m.Name.ValueString()),
+		Action:  "test",
+		SpaceID: m.SpaceID.ValueInt64(),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.Endpoint.ValueString(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if secret := m.Secret.ValueString(); secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("endpoint responded with status code %d: %s", resp.StatusCode, excerpt)
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, "de7c9b85b8b78aa6bc8a7a36f70a90701c9db4d9",
+		Sign("key", []byte("The quick brown fox jumps over the lazy dog")))
+}
@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+func TestWebhookLogsDataSource(t *testing.T) {
+	f, stop := ProviderFactories("./assets/webhook_logs")
+	defer func() {
+		_ = stop()
+	}()
+
+	dn := "data.storyblok_webhook_logs.deploy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config:      testWebhookLogsConfig(233252, 4242, 0),
+				ExpectError: regexp.MustCompile(`Attribute limit value must be between 1 and 100`),
+			},
+			{
+				Config: testWebhookLogsConfig(233252, 4242, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dn, "id", "233252/4242"),
+					resource.TestCheckResourceAttr(dn, "deliveries.#", "2"),
+					resource.TestCheckResourceAttr(dn, "deliveries.0.action", "story.unpublished"),
+					resource.TestCheckResourceAttr(dn, "deliveries.0.timestamp", "2026-10-19T08:00:00.000Z"),
+					resource.TestCheckResourceAttr(dn, "deliveries.0.success", "true"),
+					resource.TestCheckResourceAttr(dn, "deliveries.1.action", "asset.deleted"),
+					resource.TestCheckResourceAttr(dn, "deliveries.1.status_code", "502"),
+					resource.TestCheckResourceAttr(dn, "deliveries.1.success", "false"),
+					resource.TestCheckResourceAttr(dn, "deliveries.1.response_excerpt",
+						"<html><body>Bad Gateway</body></html>"),
+				),
+			},
+		},
+	})
+}
+
+func testWebhookLogsConfig(spaceId int, webhookId int, limit int) string {
+	return utils.HCLTemplate(`
+		data "storyblok_webhook_logs" "deploy" {
+		  space_id   = {{ .spaceId }}
+		  webhook_id = {{ .webhookId }}
+		  limit      = {{ .limit }}
+		}
+	`, map[string]any{
+		"spaceId":   spaceId,
+		"webhookId": webhookId,
+		"limit":     limit,
+	})
+}
@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+func TestWebhookResourceActions(t *testing.T) {
+	f, stop := ProviderFactories("./assets/webhook")
+	defer func() {
+		_ = stop()
+	}()
+
+	rn := "storyblok_webhook.deploy"
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config:      testWebhookConfig(spaceId, "story.publish", "asset.deleted"),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config: testWebhookConfig(spaceId, "story.published", "asset.deleted"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "name", "deploy"),
+					resource.TestCheckResourceAttr(rn, "actions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(rn, "actions.*", "story.published"),
+					resource.TestCheckTypeSetElemAttr(rn, "actions.*", "asset.deleted"),
+				),
+			},
+			{
+				// Reordering the actions doesn't result in a diff
+				Config:   testWebhookConfig(spaceId, "asset.deleted", "story.published"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testWebhookConfig(spaceId int, first string, second string) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_webhook" "deploy" {
+		  space_id = {{ .spaceId }}
+		  name     = "deploy"
+		  endpoint = "https://example.com/deploy"
+		  actions  = ["{{ .first }}", "{{ .second }}"]
+		}
+	`, map[string]any{
+		"spaceId": spaceId,
+		"first":   first,
+		"second":  second,
+	})
+}
+
+func TestWebhookResourceVerifyOnCreate(t *testing.T) {
+	f, stop := ProviderFactories("./assets/webhook_verify")
+	defer func() {
+		_ = stop()
+	}()
+
+	secret := "s3cr3t"
+	failing := NewWebhookReceiver(t, secret, http.StatusInternalServerError)
+	receiver := NewWebhookReceiver(t, secret, http.StatusNoContent)
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config:      testWebhookVerifyConfig(spaceId, receiver.URL, "wrong-secret"),
+				ExpectError: regexp.MustCompile(`endpoint responded with\s+status code 401: invalid signature`),
+			},
+			{
+				Config:      testWebhookVerifyConfig(spaceId, failing.URL, secret),
+				ExpectError: regexp.MustCompile(`endpoint responded with\s+status code 500`),
+			},
+			{
+				Config: testWebhookVerifyConfig(spaceId, receiver.URL, secret),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storyblok_webhook.verified", "verify_on_create", "true"),
+					func(_ *terraform.State) error {
+						deliveries := receiver.Deliveries()
+						if len(deliveries) != 1 {
+							return fmt.Errorf("expected 1 delivery, got %d", len(deliveries))
+						}
+						if !strings.Contains(string(deliveries[0]), `"action":"test"`) {
+							return fmt.Errorf("unexpected delivery %s", deliveries[0])
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testWebhookVerifyConfig(spaceId int, endpoint string, secret string) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_webhook" "verified" {
+		  space_id         = {{ .spaceId }}
+		  name             = "verified"
+		  endpoint         = "{{ .endpoint }}"
+		  secret           = "{{ .secret }}"
+		  actions          = ["story.published"]
+		  verify_on_create = true
+		}
+	`, map[string]any{
+		"spaceId":  spaceId,
+		"endpoint": endpoint,
+		"secret":   secret,
+	})
+}
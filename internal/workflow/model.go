@@ -0,0 +1,42 @@
+package workflow
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// workflowResourceModel maps the resource schema data.
+type workflowResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	WorkflowID   types.Int64    `tfsdk:"workflow_id"`
+	SpaceID      types.Int64    `tfsdk:"space_id"`
+	Name         types.String   `tfsdk:"name"`
+	ContentTypes []types.String `tfsdk:"content_types"`
+	IsDefault    types.Bool     `tfsdk:"is_default"`
+}
+
+func (m *workflowResourceModel) toInput() mapi.WorkflowInput {
+	return mapi.WorkflowInput{
+		Workflow: mapi.WorkflowBase{
+			Name:         m.Name.ValueString(),
+			ContentTypes: utils.ConvertToPointerStringSlice(m.ContentTypes),
+		},
+	}
+}
+
+func (m *workflowResourceModel) fromRemote(spaceID int64, w *mapi.Workflow) error {
+	if w == nil {
+		return fmt.Errorf("workflow is nil")
+	}
+	m.ID = types.StringValue(utils.CreateIdentifier(spaceID, w.Id))
+	m.WorkflowID = types.Int64Value(w.Id)
+	m.SpaceID = types.Int64Value(spaceID)
+	m.Name = types.StringValue(w.Name)
+	m.ContentTypes = utils.RemoteStringSlice(m.ContentTypes, w.ContentTypes)
+	m.IsDefault = types.BoolValue(w.IsDefault)
+	return nil
+}
@@ -0,0 +1,79 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+)
+
+func TestWorkflowStageResourceModel_ToInput(t *testing.T) {
+	model := &workflowStageResourceModel{
+		WorkflowID:   types.Int64Value(12),
+		Name:         types.StringValue("Legal review"),
+		Color:        types.StringValue("#fbce41"),
+		AllowPublish: types.BoolValue(false),
+		SpaceRoleIDs: []types.Int64{types.Int64Value(74689)},
+		NextStageIDs: []types.Int64{types.Int64Value(3), types.Int64Value(4)},
+	}
+
+	input := model.toInput()
+
+	assert.Equal(t, int64(12), input.WorkflowStage.WorkflowId)
+	assert.Equal(t, "Legal review", input.WorkflowStage.Name)
+	assert.Equal(t, "#fbce41", *input.WorkflowStage.Color)
+	assert.False(t, *input.WorkflowStage.AllowPublish)
+	assert.Nil(t, input.WorkflowStage.AllowAllUsers)
+	assert.Nil(t, input.WorkflowStage.UserIds)
+	assert.Equal(t, []int{74689}, *input.WorkflowStage.SpaceRoleIds)
+	assert.Equal(t, []int{3, 4}, *input.WorkflowStage.WorkflowStageIds)
+}
+
+func TestWorkflowStageResourceModel_FromRemote(t *testing.T) {
+	model := &workflowStageResourceModel{
+		SpaceRoleIDs: []types.Int64{},
+	}
+
+	err := model.fromRemote(123, &mapi.WorkflowStage{
+		Id:               456,
+		WorkflowId:       12,
+		Name:             "Legal review",
+		Color:            "#fbce41",
+		AllowPublish:     true,
+		UserIds:          []int{},
+		SpaceRoleIds:     []int{},
+		WorkflowStageIds: []int{3},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "123/456", model.ID.ValueString())
+	assert.Equal(t, int64(456), model.WorkflowStageID.ValueInt64())
+	assert.True(t, model.AllowPublish.ValueBool())
+	assert.True(t, model.Position.IsNull())
+	assert.Nil(t, model.UserIDs, "unset lists should stay null")
+	assert.Equal(t, []types.Int64{}, model.SpaceRoleIDs)
+	assert.Equal(t, []types.Int64{types.Int64Value(3)}, model.NextStageIDs)
+}
+
+func TestWorkflowResourceModel_FromRemote(t *testing.T) {
+	model := &workflowResourceModel{}
+
+	err := model.fromRemote(123, &mapi.Workflow{
+		Id:           789,
+		Name:         "Editorial",
+		ContentTypes: []string{"page"},
+	})
+	assert.NoError(t, err)
+
+	expected := &workflowResourceModel{
+		ID:           types.StringValue("123/789"),
+		WorkflowID:   types.Int64Value(789),
+		SpaceID:      types.Int64Value(123),
+		Name:         types.StringValue("Editorial"),
+		ContentTypes: []types.String{types.StringValue("page")},
+		IsDefault:    types.BoolValue(false),
+	}
+	assert.Equal(t, expected, model)
+}
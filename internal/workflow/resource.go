@@ -0,0 +1,232 @@
+package workflow
+
+import (
+	"context"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &workflowResource{}
+	_ resource.ResourceWithConfigure   = &workflowResource{}
+	_ resource.ResourceWithImportState = &workflowResource{}
+)
+
+// NewWorkflowResource is a helper function to simplify the provider implementation.
+func NewWorkflowResource() resource.Resource {
+	return &workflowResource{}
+}
+
+// workflowResource is the resource implementation.
+type workflowResource struct {
+	client *mapi.Client
+}
+
+// Metadata returns the data source type name.
+func (r *workflowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow"
+}
+
+// Schema defines the schema for the data source.
+func (r *workflowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Workflows define the stages a story goes through before it is published. Stages are managed " +
+			"with the `storyblok_workflow_stage` resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The terraform ID of the workflow. This is a composite ID, " +
+					"and should not be used as reference",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_id": schema.Int64Attribute{
+				Description: "The ID of the workflow.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": schema.Int64Attribute{
+				Description: "The ID of the space.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the workflow.",
+				Required:    true,
+			},
+			"content_types": schema.ListAttribute{
+				Description: "Names of the content types (root components) this workflow is the default for.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this is the default workflow of the space.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *workflowResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = utils.GetAPIClient(req.ProviderData)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *workflowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan workflowResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	input := plan.toInput()
+	spaceID := plan.SpaceID.ValueInt64()
+
+	content, err := r.client.CreateWorkflow(ctx, spaceID, input)
+	if d := utils.CheckCreateError("workflow", content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	workflow := &content.JSON.Workflow
+	tflog.Debug(ctx, spew.Sdump(workflow))
+
+	// Map response body to schema and populate Computed attribute values
+	if err := plan.fromRemote(spaceID, workflow); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating workflow",
+			"Could not create workflow, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state workflowResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceId, id := utils.ParseIdentifier(state.ID.ValueString())
+
+	content, err := r.client.GetWorkflow(ctx, spaceId, id)
+	if d := utils.CheckGetError("workflow", id, content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	if err := state.fromRemote(spaceId, &content.JSON.Workflow); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Storyblok Workflow",
+			"Could not read Storyblok workflow ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *workflowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan workflowResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	input := plan.toInput()
+	spaceID := plan.SpaceID.ValueInt64()
+
+	content, err := r.client.UpdateWorkflow(ctx, spaceID, plan.WorkflowID.ValueInt64(), input)
+	if d := utils.CheckUpdateError("workflow", content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	workflow := &content.JSON.Workflow
+	tflog.Debug(ctx, spew.Sdump(workflow))
+
+	// Map response body to schema and populate Computed attribute values
+	if err := plan.fromRemote(spaceID, workflow); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating workflow",
+			"Could not update workflow, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *workflowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state workflowResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceId, workflowId := utils.ParseIdentifier(state.ID.ValueString())
+	content, err := r.client.DeleteWorkflow(ctx, spaceId, workflowId)
+	if d := utils.CheckDeleteError("workflow", content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+}
+
+func (r *workflowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,81 @@
+package workflow
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// workflowStageResourceModel maps the resource schema data.
+type workflowStageResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	WorkflowStageID types.Int64  `tfsdk:"workflow_stage_id"`
+	SpaceID         types.Int64  `tfsdk:"space_id"`
+	WorkflowID      types.Int64  `tfsdk:"workflow_id"`
+	Name            types.String `tfsdk:"name"`
+	Color           types.String `tfsdk:"color"`
+	Position        types.Int64  `tfsdk:"position"`
+	IsDefault       types.Bool   `tfsdk:"is_default"`
+
+	AllowPublish      types.Bool  `tfsdk:"allow_publish"`
+	AllowAdminPublish types.Bool  `tfsdk:"allow_admin_publish"`
+	AllowAllStages    types.Bool  `tfsdk:"allow_all_stages"`
+	AllowAllUsers     types.Bool  `tfsdk:"allow_all_users"`
+	AllowAdminChange  types.Bool  `tfsdk:"allow_admin_change"`
+	AllowEditorChange types.Bool  `tfsdk:"allow_editor_change"`
+	AfterPublishID    types.Int64 `tfsdk:"after_publish_id"`
+
+	UserIDs      []types.Int64 `tfsdk:"user_ids"`
+	SpaceRoleIDs []types.Int64 `tfsdk:"space_role_ids"`
+	NextStageIDs []types.Int64 `tfsdk:"next_stage_ids"`
+}
+
+func (m *workflowStageResourceModel) toInput() mapi.WorkflowStageInput {
+	return mapi.WorkflowStageInput{
+		WorkflowStage: mapi.WorkflowStageBase{
+			WorkflowId:        m.WorkflowID.ValueInt64(),
+			Name:              m.Name.ValueString(),
+			Color:             utils.KnownStringPointer(m.Color),
+			Position:          utils.KnownInt64Pointer(m.Position),
+			IsDefault:         m.IsDefault.ValueBoolPointer(),
+			AllowPublish:      m.AllowPublish.ValueBoolPointer(),
+			AllowAdminPublish: m.AllowAdminPublish.ValueBoolPointer(),
+			AllowAllStages:    m.AllowAllStages.ValueBoolPointer(),
+			AllowAllUsers:     m.AllowAllUsers.ValueBoolPointer(),
+			AllowAdminChange:  m.AllowAdminChange.ValueBoolPointer(),
+			AllowEditorChange: m.AllowEditorChange.ValueBoolPointer(),
+			AfterPublishId:    m.AfterPublishID.ValueInt64Pointer(),
+			UserIds:           utils.ConvertToPointerIntSlice(m.UserIDs),
+			SpaceRoleIds:      utils.ConvertToPointerIntSlice(m.SpaceRoleIDs),
+			WorkflowStageIds:  utils.ConvertToPointerIntSlice(m.NextStageIDs),
+		},
+	}
+}
+
+func (m *workflowStageResourceModel) fromRemote(spaceID int64, s *mapi.WorkflowStage) error {
+	if s == nil {
+		return fmt.Errorf("workflow stage is nil")
+	}
+	m.ID = types.StringValue(utils.CreateIdentifier(spaceID, s.Id))
+	m.WorkflowStageID = types.Int64Value(s.Id)
+	m.SpaceID = types.Int64Value(spaceID)
+	m.WorkflowID = types.Int64Value(s.WorkflowId)
+	m.Name = types.StringValue(s.Name)
+	m.Color = types.StringValue(s.Color)
+	m.Position = types.Int64PointerValue(s.Position)
+	m.IsDefault = types.BoolValue(s.IsDefault)
+	m.AllowPublish = types.BoolValue(s.AllowPublish)
+	m.AllowAdminPublish = types.BoolValue(s.AllowAdminPublish)
+	m.AllowAllStages = types.BoolValue(s.AllowAllStages)
+	m.AllowAllUsers = types.BoolValue(s.AllowAllUsers)
+	m.AllowAdminChange = types.BoolValue(s.AllowAdminChange)
+	m.AllowEditorChange = types.BoolValue(s.AllowEditorChange)
+	m.AfterPublishID = types.Int64PointerValue(s.AfterPublishId)
+	m.UserIDs = utils.RemoteInt64Slice(m.UserIDs, s.UserIds)
+	m.SpaceRoleIDs = utils.RemoteInt64Slice(m.SpaceRoleIDs, s.SpaceRoleIds)
+	m.NextStageIDs = utils.RemoteInt64Slice(m.NextStageIDs, s.WorkflowStageIds)
+	return nil
+}
@@ -0,0 +1,313 @@
+package workflow
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/labd/terraform-provider-storyblok/internal/mapi"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &workflowStageResource{}
+	_ resource.ResourceWithConfigure   = &workflowStageResource{}
+	_ resource.ResourceWithImportState = &workflowStageResource{}
+)
+
+// NewWorkflowStageResource is a helper function to simplify the provider implementation.
+func NewWorkflowStageResource() resource.Resource {
+	return &workflowStageResource{}
+}
+
+// workflowStageResource is the resource implementation.
+type workflowStageResource struct {
+	client *mapi.Client
+}
+
+// Metadata returns the data source type name.
+func (r *workflowStageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_stage"
+}
+
+// Schema defines the schema for the data source.
+func (r *workflowStageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A workflow stage is a step of a workflow. Stages define who is allowed to move a story into the " +
+			"stage, which stages can follow and whether stories can be published from it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The terraform ID of the workflow stage. This is a composite ID, " +
+					"and should not be used as reference",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_stage_id": schema.Int64Attribute{
+				Description: "The ID of the workflow stage.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": schema.Int64Attribute{
+				Description: "The ID of the space.",
+				Required:    true,
+			},
+			"workflow_id": schema.Int64Attribute{
+				Description: "The ID of the workflow this stage belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the workflow stage.",
+				Required:    true,
+			},
+			"color": schema.StringAttribute{
+				Description: "The color of the workflow stage as hex value, for example `#fbce41`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^#[0-9a-fA-F]{6}$`), "must be a hex color"),
+				},
+			},
+			"position": schema.Int64Attribute{
+				Description: "The position of the stage in the workflow.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether new stories start in this stage; Default: false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"allow_publish": schema.BoolAttribute{
+				Description: "Whether stories can be published in this stage; Default: false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"allow_admin_publish": schema.BoolAttribute{
+				Description: "Whether administrators can always publish stories in this stage; Default: false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"allow_all_stages": schema.BoolAttribute{
+				Description: "Whether stories can be moved to any stage from this stage. When false only the " +
+					"`next_stage_ids` are allowed; Default: false",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"allow_all_users": schema.BoolAttribute{
+				Description: "Whether all users can move stories into this stage. When false only the `user_ids` " +
+					"and `space_role_ids` are allowed; Default: false",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"allow_admin_change": schema.BoolAttribute{
+				Description: "Whether administrators can always change the stage; Default: false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"allow_editor_change": schema.BoolAttribute{
+				Description: "Whether editors can always change the stage; Default: false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"after_publish_id": schema.Int64Attribute{
+				Description: "The ID of the stage a story is moved to after it has been published.",
+				Optional:    true,
+			},
+			"user_ids": schema.ListAttribute{
+				Description: "IDs of the users that are allowed to move stories into this stage.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
+			"space_role_ids": schema.ListAttribute{
+				Description: "IDs of the space roles that are allowed to move stories into this stage. Use the " +
+					"`role_id` of a `storyblok_space_role`.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
+			"next_stage_ids": schema.ListAttribute{
+				Description: "IDs of the workflow stages stories can be moved to from this stage.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *workflowStageResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = utils.GetAPIClient(req.ProviderData)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *workflowStageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan workflowStageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	input := plan.toInput()
+	spaceID := plan.SpaceID.ValueInt64()
+
+	content, err := r.client.CreateWorkflowStage(ctx, spaceID, input)
+	if d := utils.CheckCreateError("workflow_stage", content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	stage := &content.JSON.WorkflowStage
+	tflog.Debug(ctx, spew.Sdump(stage))
+
+	// Map response body to schema and populate Computed attribute values
+	if err := plan.fromRemote(spaceID, stage); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating workflow stage",
+			"Could not create workflow stage, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *workflowStageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state workflowStageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceId, id := utils.ParseIdentifier(state.ID.ValueString())
+
+	content, err := r.client.GetWorkflowStage(ctx, spaceId, id)
+	if d := utils.CheckGetError("workflow_stage", id, content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	if err := state.fromRemote(spaceId, &content.JSON.WorkflowStage); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Storyblok Workflow Stage",
+			"Could not read Storyblok workflow stage ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *workflowStageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan workflowStageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	input := plan.toInput()
+	spaceID := plan.SpaceID.ValueInt64()
+
+	content, err := r.client.UpdateWorkflowStage(ctx, spaceID, plan.WorkflowStageID.ValueInt64(), input)
+	if d := utils.CheckUpdateError("workflow_stage", content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	stage := &content.JSON.WorkflowStage
+	tflog.Debug(ctx, spew.Sdump(stage))
+
+	// Map response body to schema and populate Computed attribute values
+	if err := plan.fromRemote(spaceID, stage); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating workflow stage",
+			"Could not update workflow stage, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *workflowStageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state workflowStageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceId, workflowStageId := utils.ParseIdentifier(state.ID.ValueString())
+	content, err := r.client.DeleteWorkflowStage(ctx, spaceId, workflowStageId)
+	if d := utils.CheckDeleteError("workflow_stage", content, err); d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+}
+
+func (r *workflowStageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labd/terraform-provider-storyblok/internal/utils"
+)
+
+func TestWorkflowResourceBasic(t *testing.T) {
+	f, stop := ProviderFactories("./assets/workflow")
+	defer func() {
+		_ = stop()
+	}()
+
+	spaceId := 233252
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 TestAccPreCheck(t),
+		ProtoV6ProviderFactories: f,
+		Steps: []resource.TestStep{
+			{
+				Config: testWorkflowConfig(spaceId, "Legal review", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storyblok_workflow.test", "name", "Editorial"),
+					resource.TestCheckResourceAttr("storyblok_workflow.test", "content_types.#", "1"),
+					resource.TestCheckResourceAttr("storyblok_workflow.test", "content_types.0", "article"),
+					resource.TestCheckResourceAttr("storyblok_workflow_stage.review", "name", "Legal review"),
+					resource.TestCheckResourceAttr("storyblok_workflow_stage.review", "color", "#fbce41"),
+					resource.TestCheckResourceAttr("storyblok_workflow_stage.review", "allow_publish", "false"),
+					resource.TestCheckResourceAttr("storyblok_workflow_stage.review", "space_role_ids.#", "1"),
+					resource.TestCheckResourceAttr("storyblok_workflow_stage.review", "space_role_ids.0", "74689"),
+					resource.TestCheckResourceAttrPair(
+						"storyblok_workflow_stage.review", "next_stage_ids.0",
+						"storyblok_workflow_stage.ready", "workflow_stage_id"),
+					resource.TestCheckResourceAttr("storyblok_workflow_stage.ready", "allow_publish", "true"),
+				),
+			},
+			{
+				Config: testWorkflowConfig(spaceId, "Legal check", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storyblok_workflow_stage.review", "name", "Legal check"),
+					resource.TestCheckResourceAttr("storyblok_workflow_stage.review", "allow_publish", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testWorkflowConfig(spaceId int, stageName string, allowPublish bool) string {
+	return utils.HCLTemplate(`
+		resource "storyblok_workflow" "test" {
+		  space_id      = {{ .spaceId }}
+		  name          = "Editorial"
+		  content_types = ["article"]
+		}
+
+		resource "storyblok_workflow_stage" "ready" {
+		  space_id      = storyblok_workflow.test.space_id
+		  workflow_id   = storyblok_workflow.test.workflow_id
+		  name          = "Ready"
+		  color         = "#00b3b0"
+		  allow_publish = true
+		}
+
+		resource "storyblok_workflow_stage" "review" {
+		  space_id       = storyblok_workflow.test.space_id
+		  workflow_id    = storyblok_workflow.test.workflow_id
+		  name           = "{{ .stageName }}"
+		  color          = "#fbce41"
+		  allow_publish  = {{ .allowPublish }}
+		  space_role_ids = [74689]
+		  next_stage_ids = [storyblok_workflow_stage.ready.workflow_stage_id]
+		}
+	`, map[string]any{
+		"spaceId":      spaceId,
+		"stageName":    stageName,
+		"allowPublish": allowPublish,
+	})
+}
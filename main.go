@@ -4,6 +4,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	_ "time/tzdata" // timezones are validated on systems without a timezone database
 
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"